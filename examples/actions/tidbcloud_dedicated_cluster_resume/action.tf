@@ -0,0 +1,11 @@
+variable "cluster_id" {
+  type     = string
+  nullable = false
+}
+
+# terraform apply -invoke=action.tidbcloud_dedicated_cluster_resume.example
+action "tidbcloud_dedicated_cluster_resume" "example" {
+  config {
+    cluster_id = var.cluster_id
+  }
+}
@@ -0,0 +1,16 @@
+variable "cluster_id" {
+  type     = string
+  nullable = false
+}
+
+ephemeral "random_password" "root" {
+  length = 32
+}
+
+# terraform apply -invoke=action.tidbcloud_dedicated_cluster_rotate_root_password.example
+action "tidbcloud_dedicated_cluster_rotate_root_password" "example" {
+  config {
+    cluster_id    = var.cluster_id
+    root_password = ephemeral.random_password.root.result
+  }
+}
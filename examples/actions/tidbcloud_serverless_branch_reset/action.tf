@@ -0,0 +1,29 @@
+variable "cluster_id" {
+  type     = string
+  nullable = false
+}
+
+resource "tidbcloud_serverless_branch" "example" {
+  cluster_id   = var.cluster_id
+  display_name = "staging"
+  parent_id    = var.cluster_id
+}
+
+action "tidbcloud_serverless_branch_reset" "example" {
+  config {
+    cluster_id = tidbcloud_serverless_branch.example.cluster_id
+    branch_id  = tidbcloud_serverless_branch.example.branch_id
+  }
+}
+
+# reset the branch to the latest data of the cluster every time the seed version changes
+resource "terraform_data" "seed" {
+  input = "v1"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.tidbcloud_serverless_branch_reset.example]
+    }
+  }
+}
@@ -3,10 +3,20 @@ variable "cluster_id" {
   nullable = false
 }
 
+variable "project_id" {
+  type     = string
+  nullable = false
+}
+
 data "tidbcloud_serverless_cluster" "example" {
   cluster_id = var.cluster_id
 }
 
+data "tidbcloud_serverless_cluster" "by_name" {
+  project_id   = var.project_id
+  display_name = "Cluster0"
+}
+
 output "output" {
   value = data.tidbcloud_serverless_cluster.example
 }
\ No newline at end of file
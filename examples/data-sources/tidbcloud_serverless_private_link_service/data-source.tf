@@ -0,0 +1,12 @@
+variable "cluster_id" {
+  type     = string
+  nullable = false
+}
+
+data "tidbcloud_serverless_private_link_service" "example" {
+  cluster_id = var.cluster_id
+}
+
+output "output" {
+  value = data.tidbcloud_serverless_private_link_service.example
+}
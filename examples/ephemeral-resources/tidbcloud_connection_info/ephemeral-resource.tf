@@ -0,0 +1,16 @@
+variable "cluster_id" {
+  type     = string
+  nullable = false
+}
+
+variable "password" {
+  type      = string
+  nullable  = false
+  sensitive = true
+}
+
+ephemeral "tidbcloud_connection_info" "example" {
+  cluster_id = var.cluster_id
+  password   = var.password
+  database   = "test"
+}
@@ -0,0 +1,16 @@
+variable "cluster_id" {
+  type     = string
+  nullable = false
+}
+
+ephemeral "tidbcloud_sql_user_credentials" "example" {
+  cluster_id   = var.cluster_id
+  builtin_role = "role_admin"
+}
+
+provider "mysql" {
+  endpoint = "${ephemeral.tidbcloud_sql_user_credentials.example.host}:${ephemeral.tidbcloud_sql_user_credentials.example.port}"
+  username = ephemeral.tidbcloud_sql_user_credentials.example.user_name
+  password = ephemeral.tidbcloud_sql_user_credentials.example.password
+  tls      = true
+}
@@ -0,0 +1,9 @@
+output "dsn" {
+  value = provider::tidbcloud::build_dsn(
+    tidbcloud_serverless_cluster.example.endpoints.public.host,
+    tidbcloud_serverless_cluster.example.endpoints.public.port,
+    "${tidbcloud_serverless_cluster.example.user_prefix}.root",
+    "test",
+    true,
+  )
+}
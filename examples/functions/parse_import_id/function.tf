@@ -0,0 +1,9 @@
+locals {
+  # { cluster_id = "10476959660988000000", user_name = "prefix.app" }
+  sql_user = provider::tidbcloud::parse_import_id("tidbcloud_sql_user", "10476959660988000000,prefix.app")
+}
+
+import {
+  to = tidbcloud_sql_user.app
+  id = "${local.sql_user.cluster_id},${local.sql_user.user_name}"
+}
@@ -0,0 +1,4 @@
+output "cloud_provider" {
+  # "aws"
+  value = provider::tidbcloud::parse_region_name("regions/aws-us-east-1").cloud_provider
+}
@@ -0,0 +1,6 @@
+resource "tidbcloud_sql_user" "example" {
+  cluster_id   = tidbcloud_serverless_cluster.example.cluster_id
+  user_name    = provider::tidbcloud::serverless_username(tidbcloud_serverless_cluster.example.user_prefix, "app")
+  password     = var.password
+  builtin_role = "role_readwrite"
+}
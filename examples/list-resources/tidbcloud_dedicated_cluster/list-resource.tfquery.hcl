@@ -0,0 +1,7 @@
+list "tidbcloud_dedicated_cluster" "example" {
+  provider = tidbcloud
+
+  config {
+    project_id = "1372813089189561287"
+  }
+}
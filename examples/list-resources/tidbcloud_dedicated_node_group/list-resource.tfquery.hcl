@@ -0,0 +1,7 @@
+list "tidbcloud_dedicated_node_group" "example" {
+  provider = tidbcloud
+
+  config {
+    cluster_id = "10476959660988000000"
+  }
+}
@@ -24,4 +24,13 @@ provider "tidbcloud" {
   public_key  = "fake_public_key"
   private_key = "fake_private_key"
   sync        = true
-}
\ No newline at end of file
+}
+
+# If your network requires a proxy or a private CA, configure them on the provider.
+# They apply to all the requests sent by the provider.
+provider "tidbcloud" {
+  public_key   = "fake_public_key"
+  private_key  = "fake_private_key"
+  http_proxy   = "http://proxy.example.com:3128"
+  ca_cert_file = "/etc/ssl/certs/corp-ca.pem"
+}
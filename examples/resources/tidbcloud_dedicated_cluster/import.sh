@@ -0,0 +1,5 @@
+# Import a dedicated cluster by its ID
+terraform import tidbcloud_dedicated_cluster.example 10000000000000000000
+
+# Import a dedicated cluster by its display name in a project
+terraform import tidbcloud_dedicated_cluster.example "name:1234567/my-cluster"
@@ -0,0 +1,5 @@
+# Import a branch by the ID of the cluster and the ID of the branch
+terraform import tidbcloud_serverless_branch.example 10000000000000000000,bran-xxxxxxxxxxxxxxxxxxxxxxxxxx
+
+# Import a branch by the ID of the cluster and the display name of the branch
+terraform import tidbcloud_serverless_branch.example "name:10000000000000000000/my-branch"
@@ -19,6 +19,7 @@ require (
 	github.com/icholy/digest v1.1.0
 	github.com/juju/errors v1.0.0
 	github.com/tidbcloud/tidbcloud-cli/pkg v0.0.0-20250904033041-7509dcd391b2
+	golang.org/x/sync v0.19.0
 )
 
 require (
@@ -109,7 +110,6 @@ require (
 	golang.org/x/exp v0.0.0-20230626212559-97b1e661b5df // indirect
 	golang.org/x/mod v0.33.0 // indirect
 	golang.org/x/net v0.49.0 // indirect
-	golang.org/x/sync v0.19.0 // indirect
 	golang.org/x/sys v0.41.0 // indirect
 	golang.org/x/text v0.34.0 // indirect
 	golang.org/x/tools v0.41.0 // indirect
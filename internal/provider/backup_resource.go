@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	backupApi "github.com/c4pt0r/go-tidbcloud-sdk-v1/client/backup"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -132,14 +131,14 @@ func (r *backupResource) Create(ctx context.Context, req resource.CreateRequest,
 		description := data.Description.ValueString()
 		createBackupBody.Description = &description
 	}
-	createBackupOK, err := r.provider.client.CreateBackup(backupApi.NewCreateBackupParams().WithProjectID(data.ProjectId).WithClusterID(data.ClusterId).WithBody(createBackupBody))
+	createBackupOK, err := r.provider.client.CreateBackup(ctx, backupApi.NewCreateBackupParams().WithProjectID(data.ProjectId).WithClusterID(data.ClusterId).WithBody(createBackupBody))
 	if err != nil {
 		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to call create backup, got error: %s", err))
 		return
 	}
 
 	tflog.Trace(ctx, "get backup resource")
-	getBackupOfClusterOK, err := r.provider.client.GetBackupOfCluster(backupApi.NewGetBackupOfClusterParams().WithProjectID(data.ProjectId).WithClusterID(data.ClusterId).WithBackupID(createBackupOK.Payload.ID))
+	getBackupOfClusterOK, err := r.provider.client.GetBackupOfCluster(ctx, backupApi.NewGetBackupOfClusterParams().WithProjectID(data.ProjectId).WithClusterID(data.ClusterId).WithBackupID(createBackupOK.Payload.ID))
 	if err != nil {
 		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to call GetBackupById, got error: %s", err))
 		return
@@ -162,7 +161,7 @@ func (r backupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	tflog.Trace(ctx, "get backup resource")
-	getBackupOfClusterOK, err := r.provider.client.GetBackupOfCluster(backupApi.NewGetBackupOfClusterParams().WithProjectID(projectId).WithClusterID(clusterId).WithBackupID(backupId))
+	getBackupOfClusterOK, err := r.provider.client.GetBackupOfCluster(ctx, backupApi.NewGetBackupOfClusterParams().WithProjectID(projectId).WithClusterID(clusterId).WithBackupID(backupId))
 	if err != nil {
 		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to call GetBackupById, got error: %s", err))
 		return
@@ -203,7 +202,7 @@ func (r *backupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	tflog.Trace(ctx, "delete backup resource")
-	_, err := r.provider.client.DeleteBackup(backupApi.NewDeleteBackupParams().WithProjectID(data.ProjectId).WithClusterID(data.ClusterId).WithBackupID(data.BackupId.ValueString()))
+	_, err := r.provider.client.DeleteBackup(ctx, backupApi.NewDeleteBackupParams().WithProjectID(data.ProjectId).WithClusterID(data.ClusterId).WithBackupID(data.BackupId.ValueString()))
 	if err != nil {
 		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Unable to call DeleteBackupById, got error: %s", err))
 		return
@@ -211,13 +210,9 @@ func (r *backupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *backupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: project_id,cluster_id,backup_id. Got: %q", req.ID),
-		)
+	idParts, err := parseImportID(req.ID, importIDFormats["tidbcloud_backup"]...)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
 		return
 	}
 
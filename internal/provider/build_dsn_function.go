@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type buildDSNFunction struct{}
+
+var _ function.Function = &buildDSNFunction{}
+
+func NewBuildDSNFunction() function.Function {
+	return &buildDSNFunction{}
+}
+
+func (f *buildDSNFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_dsn"
+}
+
+func (f *buildDSNFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build a DSN",
+		MarkdownDescription: "Returns the DSN in the format of the Go MySQL driver, e.g. `user@tcp(host:4000)/database?tls=true`. The password is not included, use the `tidbcloud_connection_info` ephemeral resource to get a DSN with the password.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "host",
+				MarkdownDescription: "The host of the endpoint.",
+			},
+			function.Int64Parameter{
+				Name:                "port",
+				MarkdownDescription: "The port of the endpoint.",
+			},
+			function.StringParameter{
+				Name:                "user",
+				MarkdownDescription: "The user name.",
+			},
+			function.StringParameter{
+				Name:                "db",
+				MarkdownDescription: "The default database, can be empty.",
+			},
+			function.BoolParameter{
+				Name:                "tls",
+				MarkdownDescription: "Whether to connect with TLS. It must be true for serverless cluster.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *buildDSNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var host, user, db string
+	var port int64
+	var tls bool
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &host, &port, &user, &db, &tls))
+	if resp.Error != nil {
+		return
+	}
+
+	if host == "" {
+		resp.Error = function.NewArgumentFuncError(0, "the host can't be empty")
+		return
+	}
+	if port <= 0 || port > 65535 {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("invalid port %d", port))
+		return
+	}
+	if user == "" {
+		resp.Error = function.NewArgumentFuncError(2, "the user can't be empty")
+		return
+	}
+	conn := connectionParams{
+		Host:     host,
+		Port:     int32(port),
+		User:     user,
+		Database: db,
+		TLS:      tls,
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, conn.DSN()))
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestUTBuildDSNFunction(t *testing.T) {
+	setupTestEnv()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "dsn" {
+  value = provider::tidbcloud::build_dsn("gateway01.us-east-1.prod.aws.tidbcloud.com", 4000, "prefix.root", "test", true)
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("dsn", "prefix.root@tcp(gateway01.us-east-1.prod.aws.tidbcloud.com:4000)/test?tls=true"),
+				),
+			},
+			{
+				Config: `
+output "dsn" {
+  value = provider::tidbcloud::build_dsn("localhost", 0, "root", "", false)
+}
+`,
+				ExpectError: regexp.MustCompile(`invalid port 0`),
+			},
+		},
+	})
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/dedicated"
+	clusterV1beta1 "github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/serverless/cluster"
+	"golang.org/x/sync/singleflight"
+)
+
+// catalogCacheTTL is how long a catalog lookup is reused. The catalog (regions, cloud providers)
+// rarely changes, and a provider process only lives for a single plan or apply.
+const catalogCacheTTL = 10 * time.Minute
+
+// catalogCache caches the catalog lookups of a provider, so data sources and resources can call
+// them many times per run while only hitting the API once. Concurrent lookups of the same key
+// are deduplicated.
+type catalogCache struct {
+	ttl   time.Duration
+	now   func() time.Time
+	group singleflight.Group
+
+	mu      sync.Mutex
+	entries map[string]catalogCacheEntry
+}
+
+type catalogCacheEntry struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+func newCatalogCache(ttl time.Duration) *catalogCache {
+	return &catalogCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]catalogCacheEntry),
+	}
+}
+
+// getOrLoad returns the cached value of key, or calls load and caches its result.
+// Errors are not cached.
+func getOrLoad[T any](c *catalogCache, key string, load func() (T, error)) (T, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && c.now().Before(entry.expiresAt) {
+		return entry.value.(T), nil
+	}
+
+	v, err, _ := c.group.Do(key, func() (interface{}, error) {
+		// the key may have been loaded by a call which finished after the check above
+		c.mu.Lock()
+		entry, ok := c.entries[key]
+		c.mu.Unlock()
+		if ok && c.now().Before(entry.expiresAt) {
+			return entry.value, nil
+		}
+		value, err := load()
+		if err != nil {
+			return nil, err
+		}
+		c.mu.Lock()
+		c.entries[key] = catalogCacheEntry{
+			value:     value,
+			expiresAt: c.now().Add(c.ttl),
+		}
+		c.mu.Unlock()
+		return value, nil
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return v.(T), nil
+}
+
+// listServerlessRegions returns the serverless regions through the catalog cache.
+func (p *tidbcloudProvider) listServerlessRegions(ctx context.Context) ([]clusterV1beta1.Commonv1beta1Region, error) {
+	return getOrLoad(p.catalog, "serverless/regions", func() ([]clusterV1beta1.Commonv1beta1Region, error) {
+		return p.ServerlessClient.ListProviderRegions(ctx)
+	})
+}
+
+// listDedicatedRegions returns the dedicated regions through the catalog cache.
+func (p *tidbcloudProvider) listDedicatedRegions(ctx context.Context, cloudProvider string, projectId string) ([]dedicated.Commonv1beta1Region, error) {
+	key := fmt.Sprintf("dedicated/regions?cloud_provider=%s&project_id=%s", cloudProvider, projectId)
+	return getOrLoad(p.catalog, key, func() ([]dedicated.Commonv1beta1Region, error) {
+		return p.DedicatedClient.ListRegions(ctx, cloudProvider, projectId)
+	})
+}
+
+// getDedicatedRegion returns a dedicated region through the catalog cache.
+func (p *tidbcloudProvider) getDedicatedRegion(ctx context.Context, regionId string) (*dedicated.Commonv1beta1Region, error) {
+	return getOrLoad(p.catalog, "dedicated/regions/"+regionId, func() (*dedicated.Commonv1beta1Region, error) {
+		return p.DedicatedClient.GetRegion(ctx, regionId)
+	})
+}
+
+// listDedicatedCloudProviders returns the dedicated cloud providers through the catalog cache.
+func (p *tidbcloudProvider) listDedicatedCloudProviders(ctx context.Context, projectId string) ([]dedicated.V1beta1RegionCloudProvider, error) {
+	return getOrLoad(p.catalog, "dedicated/cloud_providers?project_id="+projectId, func() ([]dedicated.V1beta1RegionCloudProvider, error) {
+		return p.DedicatedClient.ListCloudProviders(ctx, projectId)
+	})
+}
+
+// listDedicatedNodeSpecs returns the node specs of a dedicated region through the catalog cache.
+func (p *tidbcloudProvider) listDedicatedNodeSpecs(ctx context.Context, regionId string) ([]dedicated.Dedicatedv1beta1NodeSpec, error) {
+	return getOrLoad(p.catalog, "dedicated/regions/"+regionId+"/node_specs", func() ([]dedicated.Dedicatedv1beta1NodeSpec, error) {
+		return p.DedicatedClient.ListNodeSpecs(ctx, regionId)
+	})
+}
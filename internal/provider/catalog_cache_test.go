@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestUTCatalogCache(t *testing.T) {
+	now := time.Now()
+	c := newCatalogCache(time.Minute)
+	c.now = func() time.Time { return now }
+
+	var calls int
+	load := func() ([]string, error) {
+		calls++
+		return []string{"aws-us-east-1"}, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		regions, err := getOrLoad(c, "regions", load)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(regions) != 1 || regions[0] != "aws-us-east-1" {
+			t.Fatalf("unexpected regions: %v", regions)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("expected 1 call before the ttl, got %d", calls)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, err := getOrLoad(c, "regions", load); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 calls after the ttl, got %d", calls)
+	}
+}
+
+func TestUTCatalogCacheErrorNotCached(t *testing.T) {
+	c := newCatalogCache(time.Minute)
+
+	var calls int
+	load := func() (string, error) {
+		calls++
+		if calls == 1 {
+			return "", errors.New("unavailable")
+		}
+		return "ok", nil
+	}
+
+	if _, err := getOrLoad(c, "key", load); err == nil {
+		t.Fatal("expected error")
+	}
+	v, err := getOrLoad(c, "key", load)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != "ok" || calls != 2 {
+		t.Fatalf("unexpected value %q after %d calls", v, calls)
+	}
+}
+
+func TestUTCatalogCacheDeduplicate(t *testing.T) {
+	c := newCatalogCache(time.Minute)
+
+	var calls int32
+	release := make(chan struct{})
+	load := func() (int, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return 42, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := getOrLoad(c, "key", load)
+			if err != nil || v != 42 {
+				t.Errorf("unexpected result %d, %v", v, err)
+			}
+		}()
+	}
+	// give the goroutines the chance to join the in-flight call
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Fatalf("expected 1 call, got %d", n)
+	}
+}
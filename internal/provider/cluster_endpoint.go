@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/tidbcloud/terraform-provider-tidbcloud/tidbcloud"
+	branchV1beta1 "github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/serverless/branch"
+	clusterV1beta1 "github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/serverless/cluster"
+)
+
+const (
+	EndpointTypePublic      = "public"
+	EndpointTypePrivateLink = "private_link"
+	EndpointTypeVpcPeering  = "vpc_peering"
+	// EndpointTypePrivate is the former name of EndpointTypePrivateLink, it is still accepted.
+	EndpointTypePrivate = "private"
+)
+
+// dedicatedConnectionTypes maps the endpoint types to the connection types of the dedicated endpoints.
+var dedicatedConnectionTypes = map[string]string{
+	EndpointTypePublic:      "PUBLIC",
+	EndpointTypePrivateLink: "PRIVATE_ENDPOINT",
+	EndpointTypePrivate:     "PRIVATE_ENDPOINT",
+	EndpointTypeVpcPeering:  "VPC_PEERING",
+}
+
+// clusterEndpoint is the endpoint used to connect to a serverless cluster, a serverless branch
+// or a dedicated cluster.
+type clusterEndpoint struct {
+	Host string
+	Port int32
+	// Serverless is true for serverless clusters and branches, which always require TLS.
+	Serverless bool
+	// UserPrefix is the prefix of the sql users of a serverless cluster or branch.
+	UserPrefix string
+	// TiProxy is true if the connections of a dedicated cluster go through TiProxy.
+	TiProxy bool
+	// PrivateLinkService is the service a private link endpoint connects to, i.e. the AWS endpoint
+	// service name, the GCP service attachment or the Azure private link service alias.
+	PrivateLinkService string
+}
+
+// resolveClusterEndpoint returns the endpoint of the given type of a cluster, or of a branch
+// if branchId is not empty. The cluster is looked up as a serverless cluster first, then as a
+// dedicated cluster.
+func resolveClusterEndpoint(ctx context.Context, p *tidbcloudProvider, clusterId string, branchId string, endpointType string) (*clusterEndpoint, error) {
+	if _, ok := dedicatedConnectionTypes[endpointType]; !ok {
+		return nil, fmt.Errorf("unsupported endpoint type %q, available values [%s, %s, %s]",
+			endpointType, EndpointTypePublic, EndpointTypePrivateLink, EndpointTypeVpcPeering)
+	}
+
+	if branchId != "" {
+		branch, err := p.ServerlessClient.GetBranch(ctx, clusterId, branchId, branchV1beta1.BRANCHSERVICEGETBRANCHVIEWPARAMETER_FULL)
+		if err != nil {
+			return nil, err
+		}
+		return serverlessBranchEndpoint(branch, endpointType)
+	}
+
+	cluster, err := p.ServerlessClient.GetCluster(ctx, clusterId, clusterV1beta1.CLUSTERSERVICEGETCLUSTERVIEWPARAMETER_FULL)
+	if err == nil {
+		return serverlessClusterEndpoint(cluster, endpointType)
+	}
+	if !tidbcloud.IsNotFound(err) {
+		return nil, err
+	}
+	return dedicatedClusterEndpoint(ctx, p, clusterId, endpointType)
+}
+
+func serverlessClusterEndpoint(cluster *clusterV1beta1.TidbCloudOpenApiserverlessv1beta1Cluster, endpointType string) (*clusterEndpoint, error) {
+	endpoint := &clusterEndpoint{Serverless: true, UserPrefix: cluster.GetUserPrefix()}
+	e := cluster.Endpoints
+	switch endpointType {
+	case EndpointTypePublic:
+		if e != nil && e.Public != nil && e.Public.GetHost() != "" && !e.Public.GetDisabled() {
+			endpoint.Host, endpoint.Port = e.Public.GetHost(), e.Public.GetPort()
+			return endpoint, nil
+		}
+	case EndpointTypePrivateLink, EndpointTypePrivate:
+		if e != nil && e.Private != nil && e.Private.GetHost() != "" {
+			endpoint.Host, endpoint.Port = e.Private.GetHost(), e.Private.GetPort()
+			switch {
+			case e.Private.Aws != nil:
+				endpoint.PrivateLinkService = e.Private.Aws.GetServiceName()
+			case e.Private.Gcp != nil:
+				endpoint.PrivateLinkService = e.Private.Gcp.GetServiceAttachmentName()
+			case e.Private.Azure != nil:
+				endpoint.PrivateLinkService = e.Private.Azure.GetAlias()
+			}
+			return endpoint, nil
+		}
+	case EndpointTypeVpcPeering:
+		return nil, fmt.Errorf("the %s endpoint is not available for serverless cluster", endpointType)
+	}
+	return nil, fmt.Errorf("the %s endpoint of serverless cluster %s is not available", endpointType, cluster.GetClusterId())
+}
+
+func serverlessBranchEndpoint(branch *branchV1beta1.Branch, endpointType string) (*clusterEndpoint, error) {
+	endpoint := &clusterEndpoint{Serverless: true}
+	if branch.UserPrefix.IsSet() && branch.UserPrefix.Get() != nil {
+		endpoint.UserPrefix = *branch.UserPrefix.Get()
+	}
+	e := branch.Endpoints
+	switch endpointType {
+	case EndpointTypePublic:
+		if e != nil && e.Public != nil && e.Public.GetHost() != "" && !e.Public.GetDisabled() {
+			endpoint.Host, endpoint.Port = e.Public.GetHost(), e.Public.GetPort()
+			return endpoint, nil
+		}
+	case EndpointTypePrivateLink, EndpointTypePrivate:
+		if e != nil && e.Private != nil && e.Private.GetHost() != "" {
+			endpoint.Host, endpoint.Port = e.Private.GetHost(), e.Private.GetPort()
+			return endpoint, nil
+		}
+	case EndpointTypeVpcPeering:
+		return nil, fmt.Errorf("the %s endpoint is not available for serverless branch", endpointType)
+	}
+	return nil, fmt.Errorf("the %s endpoint of serverless branch %s is not available", endpointType, branch.GetBranchId())
+}
+
+// dedicatedClusterEndpoint returns the endpoint of the default TiDB node group of a dedicated cluster.
+func dedicatedClusterEndpoint(ctx context.Context, p *tidbcloudProvider, clusterId string, endpointType string) (*clusterEndpoint, error) {
+	cluster, err := p.DedicatedClient.GetCluster(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	connectionType := dedicatedConnectionTypes[endpointType]
+	for _, group := range cluster.TidbNodeSetting.TidbNodeGroups {
+		if !group.GetIsDefaultGroup() {
+			continue
+		}
+		if endpointType == EndpointTypePublic {
+			// the public endpoint is listed even if it is disabled
+			setting, err := p.DedicatedClient.GetPublicEndpoint(ctx, clusterId, group.GetTidbNodeGroupId())
+			if err != nil {
+				return nil, err
+			}
+			if setting == nil || !setting.GetEnabled() {
+				return nil, fmt.Errorf("the public endpoint of dedicated cluster %s is disabled", clusterId)
+			}
+		}
+		for _, e := range group.Endpoints {
+			if !strings.EqualFold(string(e.GetConnectionType()), connectionType) || e.GetHost() == "" {
+				continue
+			}
+			endpoint := &clusterEndpoint{
+				Host:    e.GetHost(),
+				Port:    e.GetPort(),
+				TiProxy: group.TiproxySetting != nil && group.TiproxySetting.GetNodeCount() > 0,
+			}
+			if connectionType == dedicatedConnectionTypes[EndpointTypePrivateLink] {
+				service, err := p.DedicatedClient.GetPrivateLinkService(ctx, clusterId, group.GetTidbNodeGroupId())
+				if err != nil {
+					return nil, err
+				}
+				if service != nil {
+					endpoint.PrivateLinkService = service.GetServiceName()
+				}
+			}
+			return endpoint, nil
+		}
+	}
+	return nil, fmt.Errorf("the %s endpoint of dedicated cluster %s is not available", endpointType, clusterId)
+}
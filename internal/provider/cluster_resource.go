@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"net/http"
 	"sort"
-	"strings"
 	"time"
 
 	clusterApi "github.com/c4pt0r/go-tidbcloud-sdk-v1/client/cluster"
@@ -122,7 +121,7 @@ func (r *clusterResource) Configure(_ context.Context, req resource.ConfigureReq
 
 func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "cluster resource(deprecated). Use serverless_cluster and dedicated_cluster resources instead for more comprehensive functions",
+		MarkdownDescription: "cluster resource(deprecated). Use serverless_cluster and dedicated_cluster resources instead for more comprehensive functions. The existing clusters can be moved to them with a moved block, DEVELOPER clusters to serverless_cluster and DEDICATED clusters to dedicated_cluster.",
 		Attributes: map[string]schema.Attribute{
 			"project_id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the project. You can get the project ID from [tidbcloud_projects datasource](../data-sources/projects.md).",
@@ -429,7 +428,7 @@ func (r clusterResource) Create(ctx context.Context, req resource.CreateRequest,
 	// see https://pkg.go.dev/github.com/hashicorp/terraform-plugin-log/tflog
 	tflog.Trace(ctx, "created cluster_resource")
 	createClusterParams := clusterApi.NewCreateClusterParams().WithProjectID(data.ProjectId).WithBody(buildCreateClusterBody(data))
-	createClusterResp, err := r.provider.client.CreateCluster(createClusterParams)
+	createClusterResp, err := r.provider.client.CreateCluster(ctx, createClusterParams)
 	if err != nil {
 		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to call CreateCluster, got error: %s", err))
 		return
@@ -465,7 +464,7 @@ func (r clusterResource) Create(ctx context.Context, req resource.CreateRequest,
 		// we refresh in create for any unknown value. if someone has other opinions which is better, he can delete the refresh logic
 		tflog.Trace(ctx, "read cluster_resource")
 		getClusterParams := clusterApi.NewGetClusterParams().WithProjectID(data.ProjectId).WithClusterID(data.ClusterId.ValueString())
-		getClusterResp, err := r.provider.client.GetCluster(getClusterParams)
+		getClusterResp, err := r.provider.client.GetCluster(ctx, getClusterParams)
 		if err != nil {
 			resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to call GetCluster, got error: %s", err))
 			return
@@ -554,7 +553,7 @@ func (r clusterResource) Read(ctx context.Context, req resource.ReadRequest, res
 	// call read api
 	tflog.Trace(ctx, "read cluster_resource")
 	getClusterParams := clusterApi.NewGetClusterParams().WithProjectID(projectId).WithClusterID(clusterId)
-	getClusterResp, err := r.provider.client.GetCluster(getClusterParams)
+	getClusterResp, err := r.provider.client.GetCluster(ctx, getClusterParams)
 	if err != nil {
 		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to call GetClusterById, got error: %s", err))
 		return
@@ -813,7 +812,7 @@ func (r clusterResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	tflog.Trace(ctx, "update cluster_resource")
 	updateClusterParams := clusterApi.NewUpdateClusterParams().WithProjectID(data.ProjectId).WithClusterID(data.ClusterId.ValueString()).WithBody(updateClusterBody)
-	_, err := r.provider.client.UpdateCluster(updateClusterParams)
+	_, err := r.provider.client.UpdateCluster(ctx, updateClusterParams)
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to call UpdateClusterById, got error: %s", err))
 		return
@@ -833,7 +832,7 @@ func (r clusterResource) Update(ctx context.Context, req resource.UpdateRequest,
 	} else {
 		// we refresh for any unknown value. if someone has other opinions which is better, he can delete the refresh logic
 		tflog.Trace(ctx, "read cluster_resource")
-		getClusterResp, err := r.provider.client.GetCluster(clusterApi.NewGetClusterParams().WithProjectID(data.ProjectId).WithClusterID(data.ClusterId.ValueString()))
+		getClusterResp, err := r.provider.client.GetCluster(ctx, clusterApi.NewGetClusterParams().WithProjectID(data.ProjectId).WithClusterID(data.ClusterId.ValueString()))
 		if err != nil {
 			resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to call GetClusterById, got error: %s", err))
 			return
@@ -857,7 +856,7 @@ func (r clusterResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	tflog.Trace(ctx, "delete cluster_resource")
-	_, err := r.provider.client.DeleteCluster(clusterApi.NewDeleteClusterParams().WithProjectID(data.ProjectId).WithClusterID(data.ClusterId.ValueString()))
+	_, err := r.provider.client.DeleteCluster(ctx, clusterApi.NewDeleteClusterParams().WithProjectID(data.ProjectId).WithClusterID(data.ClusterId.ValueString()))
 	if err != nil {
 		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Unable to call DeleteClusterById, got error: %s", err))
 		return
@@ -865,13 +864,9 @@ func (r clusterResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r clusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: project_id,cluster_id. Got: %q", req.ID),
-		)
+	idParts, err := parseImportID(req.ID, importIDFormats["tidbcloud_cluster"]...)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
 		return
 	}
 
@@ -912,8 +907,8 @@ func WaitClusterReady(ctx context.Context, timeout time.Duration, interval time.
 func clusterStateRefreshFunc(ctx context.Context, projectId, clusterId string,
 	client tidbcloud.TiDBCloudClient) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		param := clusterApi.NewGetClusterParams().WithProjectID(projectId).WithClusterID(clusterId).WithContext(ctx)
-		getClusterResp, err := client.GetCluster(param)
+		param := clusterApi.NewGetClusterParams().WithProjectID(projectId).WithClusterID(clusterId)
+		getClusterResp, err := client.GetCluster(ctx, param)
 		if err != nil {
 			tflog.Warn(ctx, fmt.Sprintf("get cluster error: %s", err))
 			if getClusterResp != nil && getClusterResp.Code() < http.StatusInternalServerError {
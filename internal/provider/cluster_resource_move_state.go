@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	clusterV1beta1 "github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/serverless/cluster"
+)
+
+const legacyClusterTypeName = "tidbcloud_cluster"
+
+var _ resource.ResourceWithMoveState = &dedicatedClusterResource{}
+var _ resource.ResourceWithMoveState = &serverlessClusterResource{}
+
+// legacyClusterSchema returns the schema of the legacy tidbcloud_cluster resource.
+func legacyClusterSchema(ctx context.Context) *resource.SchemaResponse {
+	resp := &resource.SchemaResponse{}
+	(&clusterResource{}).Schema(ctx, resource.SchemaRequest{}, resp)
+	return resp
+}
+
+// getLegacyClusterSource returns the state of the legacy tidbcloud_cluster resource if the move
+// request comes from it. ok is false if the source is another resource, which is left to the
+// other state movers.
+func getLegacyClusterSource(ctx context.Context, req resource.MoveStateRequest, clusterType string, targetTypeName string) (data *clusterResourceData, ok bool, diags diag.Diagnostics) {
+	if req.SourceTypeName != legacyClusterTypeName || !strings.HasSuffix(req.SourceProviderAddress, "tidbcloud/tidbcloud") {
+		return nil, false, nil
+	}
+	if req.SourceState == nil {
+		diags.AddError("Unable to Move Resource State",
+			fmt.Sprintf("The state of %s can not be read with schema version %d.", legacyClusterTypeName, req.SourceSchemaVersion))
+		return nil, true, diags
+	}
+
+	data = &clusterResourceData{}
+	diags.Append(req.SourceState.Get(ctx, data)...)
+	if diags.HasError() {
+		return nil, true, diags
+	}
+	if data.ClusterType != clusterType {
+		diags.AddError("Unable to Move Resource State",
+			fmt.Sprintf("Cluster %s is a %s cluster, only %s clusters can be moved to %s.", data.ClusterId.ValueString(), data.ClusterType, clusterType, targetTypeName))
+		return nil, true, diags
+	}
+	return data, true, diags
+}
+
+func (r *dedicatedClusterResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			SourceSchema: &legacyClusterSchema(ctx).Schema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				source, ok, diags := getLegacyClusterSource(ctx, req, ded, "tidbcloud_dedicated_cluster")
+				resp.Diagnostics.Append(diags...)
+				if !ok || resp.Diagnostics.HasError() {
+					return
+				}
+
+				data := convertLegacyClusterToDedicatedCluster(source)
+
+				tflog.Trace(ctx, "move tidbcloud_cluster to dedicated_cluster_resource")
+				clusterId := data.ClusterId.ValueString()
+				cluster, err := r.provider.DedicatedClient.GetCluster(ctx, clusterId)
+				if err != nil {
+					resp.Diagnostics.AddError("Move Error", fmt.Sprintf("Unable to call GetCluster, got error: %s", err))
+					return
+				}
+				resp.Diagnostics.Append(refreshDedicatedClusterResourceData(ctx, cluster, data)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				publicEndpointSetting, err := r.provider.DedicatedClient.GetPublicEndpoint(ctx, clusterId, data.TiDBNodeSetting.NodeGroupId.ValueString())
+				if err != nil {
+					resp.Diagnostics.AddError("Move Error", fmt.Sprintf("Unable to call GetPublicEndpoint, got error: %s", err))
+					return
+				}
+				data.TiDBNodeSetting.PublicEndpointSetting = convertDedicatedPublicEndpointSetting(publicEndpointSetting)
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, data)...)
+				resp.Diagnostics.Append(resp.TargetIdentity.Set(ctx, dedicatedClusterResourceIdentity{ClusterId: data.ClusterId})...)
+			},
+		},
+	}
+}
+
+func (r *serverlessClusterResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			SourceSchema: &legacyClusterSchema(ctx).Schema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				source, ok, diags := getLegacyClusterSource(ctx, req, dev, "tidbcloud_serverless_cluster")
+				resp.Diagnostics.Append(diags...)
+				if !ok || resp.Diagnostics.HasError() {
+					return
+				}
+
+				tflog.Trace(ctx, "move tidbcloud_cluster to serverless_cluster_resource")
+				clusterId := source.ClusterId.ValueString()
+				cluster, err := r.provider.ServerlessClient.GetCluster(ctx, clusterId, clusterV1beta1.CLUSTERSERVICEGETCLUSTERVIEWPARAMETER_FULL)
+				if err != nil {
+					resp.Diagnostics.AddError("Move Error", fmt.Sprintf("Unable to call GetCluster, got error: %s", err))
+					return
+				}
+				data := serverlessClusterResourceData{
+					ProjectId:   types.StringValue(source.ProjectId),
+					ClusterId:   source.ClusterId,
+					DisplayName: types.StringValue(source.Name),
+				}
+				err = refreshServerlessClusterResourceData(ctx, cluster, &data)
+				if err != nil {
+					resp.Diagnostics.AddError("Refresh Error", fmt.Sprintf("Unable to refresh serverless cluster resource data, got error: %s", err))
+					return
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+				resp.Diagnostics.Append(resp.TargetIdentity.Set(ctx, serverlessClusterResourceIdentity{ClusterId: data.ClusterId})...)
+			},
+		},
+	}
+}
+
+// convertLegacyClusterToDedicatedCluster maps the state of a legacy dedicated cluster to the
+// dedicated cluster resource. root_password and paused are kept as they can not be read from the
+// API, the other attributes are refreshed afterwards. The ip access list of the legacy cluster is
+// not mapped, the public endpoint setting is read from the API instead.
+func convertLegacyClusterToDedicatedCluster(source *clusterResourceData) *dedicatedClusterResourceData {
+	data := &dedicatedClusterResourceData{
+		ProjectId:     types.StringValue(source.ProjectId),
+		ClusterId:     source.ClusterId,
+		DisplayName:   types.StringValue(source.Name),
+		CloudProvider: types.StringValue(strings.ToLower(source.CloudProvider)),
+		RootPassword:  source.Config.RootPassword,
+		Port:          types.Int32Null(),
+		Paused:        types.BoolPointerValue(source.Config.Paused),
+	}
+	if IsKnown(source.Config.Port) {
+		data.Port = types.Int32Value(int32(source.Config.Port.ValueInt64()))
+	}
+
+	if c := source.Config.Components; c != nil {
+		if c.TiDB != nil {
+			data.TiDBNodeSetting.NodeSpecKey = types.StringValue(c.TiDB.NodeSize)
+			data.TiDBNodeSetting.NodeCount = types.Int32Value(c.TiDB.NodeQuantity)
+		}
+		if c.TiKV != nil {
+			data.TiKVNodeSetting.NodeSpecKey = types.StringValue(c.TiKV.NodeSize)
+			data.TiKVNodeSetting.NodeCount = types.Int32Value(c.TiKV.NodeQuantity)
+			data.TiKVNodeSetting.StorageSizeGi = types.Int32Value(c.TiKV.StorageSizeGib)
+		}
+		if c.TiFlash != nil {
+			data.TiFlashNodeSetting = &tiflashNodeSetting{
+				NodeSpecKey:   types.StringValue(c.TiFlash.NodeSize),
+				NodeCount:     types.Int32Value(c.TiFlash.NodeQuantity),
+				StorageSizeGi: types.Int32Value(c.TiFlash.StorageSizeGib),
+			}
+		}
+	}
+
+	return data
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUTConvertLegacyClusterToDedicatedCluster(t *testing.T) {
+	paused := true
+	source := &clusterResourceData{
+		ClusterId:     types.StringValue("cluster_id"),
+		ProjectId:     "project_id",
+		Name:          "test-tf",
+		ClusterType:   ded,
+		CloudProvider: "AWS",
+		Region:        "us-east-1",
+		Config: clusterConfig{
+			Paused:       &paused,
+			RootPassword: types.StringValue("password"),
+			Port:         types.Int64Value(4000),
+			Components: &components{
+				TiDB:    &componentTiDB{NodeSize: "8C16G", NodeQuantity: 2},
+				TiKV:    &componentTiKV{NodeSize: "8C32G", StorageSizeGib: 500, NodeQuantity: 3},
+				TiFlash: &componentTiFlash{NodeSize: "8C64G", StorageSizeGib: 500, NodeQuantity: 1},
+			},
+			IPAccessList: []ipAccess{
+				{CIDR: "0.0.0.0/0", Description: "all"},
+			},
+		},
+	}
+
+	data := convertLegacyClusterToDedicatedCluster(source)
+
+	if data.ClusterId.ValueString() != "cluster_id" || data.ProjectId.ValueString() != "project_id" || data.DisplayName.ValueString() != "test-tf" {
+		t.Errorf("unexpected cluster: %v", data)
+	}
+	if data.CloudProvider.ValueString() != "aws" {
+		t.Errorf("expected cloud provider aws, got %s", data.CloudProvider.ValueString())
+	}
+	if data.RootPassword.ValueString() != "password" || !data.Paused.ValueBool() || data.Port.ValueInt32() != 4000 {
+		t.Errorf("unexpected config: %v", data)
+	}
+	if data.TiDBNodeSetting.NodeSpecKey.ValueString() != "8C16G" || data.TiDBNodeSetting.NodeCount.ValueInt32() != 2 {
+		t.Errorf("unexpected tidb node setting: %v", data.TiDBNodeSetting)
+	}
+	if data.TiKVNodeSetting.NodeSpecKey.ValueString() != "8C32G" || data.TiKVNodeSetting.NodeCount.ValueInt32() != 3 || data.TiKVNodeSetting.StorageSizeGi.ValueInt32() != 500 {
+		t.Errorf("unexpected tikv node setting: %v", data.TiKVNodeSetting)
+	}
+	if data.TiFlashNodeSetting == nil || data.TiFlashNodeSetting.NodeSpecKey.ValueString() != "8C64G" || data.TiFlashNodeSetting.NodeCount.ValueInt32() != 1 {
+		t.Errorf("unexpected tiflash node setting: %v", data.TiFlashNodeSetting)
+	}
+	if data.TiDBNodeSetting.PublicEndpointSetting != nil {
+		t.Errorf("expected the public endpoint setting to be read from the API, got %v", data.TiDBNodeSetting.PublicEndpointSetting)
+	}
+}
+
+func TestUTConvertLegacyClusterToDedicatedClusterWithoutTiFlash(t *testing.T) {
+	source := &clusterResourceData{
+		ClusterId:   types.StringValue("cluster_id"),
+		ClusterType: ded,
+		Config: clusterConfig{
+			RootPassword: types.StringNull(),
+			Port:         types.Int64Null(),
+			Components: &components{
+				TiDB: &componentTiDB{NodeSize: "8C16G", NodeQuantity: 2},
+				TiKV: &componentTiKV{NodeSize: "8C32G", StorageSizeGib: 500, NodeQuantity: 3},
+			},
+		},
+	}
+
+	data := convertLegacyClusterToDedicatedCluster(source)
+	if data.TiFlashNodeSetting != nil {
+		t.Errorf("expected no tiflash node setting, got %v", data.TiFlashNodeSetting)
+	}
+	if !data.Port.IsNull() || !data.Paused.IsNull() {
+		t.Errorf("expected null port and paused, got %v and %v", data.Port, data.Paused)
+	}
+}
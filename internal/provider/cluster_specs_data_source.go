@@ -232,7 +232,7 @@ func (d *clusterSpecsDataSource) Read(ctx context.Context, req datasource.ReadRe
 	}
 
 	tflog.Trace(ctx, "read cluster_specs data source")
-	listProviderRegionsOK, err := d.provider.client.ListProviderRegions(clusterApi.NewListProviderRegionsParams())
+	listProviderRegionsOK, err := d.provider.client.ListProviderRegions(ctx, clusterApi.NewListProviderRegionsParams())
 	if err != nil {
 		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to call read specifications, got error: %s", err))
 		return
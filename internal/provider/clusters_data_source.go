@@ -305,7 +305,7 @@ func (d *clustersDataSource) Read(ctx context.Context, req datasource.ReadReques
 
 	tflog.Trace(ctx, "read clusters data source")
 	listClustersOfProjectParams := clusterApi.NewListClustersOfProjectParams().WithProjectID(data.ProjectId.ValueString()).WithPage(&page).WithPageSize(&pageSize)
-	listClustersOfProjectResp, err := d.provider.client.ListClustersOfProject(listClustersOfProjectParams)
+	listClustersOfProjectResp, err := d.provider.client.ListClustersOfProject(ctx, listClustersOfProjectParams)
 	if err != nil {
 		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to call read cluster, got error: %s", err))
 		return
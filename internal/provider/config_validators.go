@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// exactlyOneOfValidator checks that exactly one of the attributes is configured.
+type exactlyOneOfValidator struct {
+	paths []path.Path
+}
+
+var _ datasource.ConfigValidator = exactlyOneOfValidator{}
+var _ resource.ConfigValidator = exactlyOneOfValidator{}
+
+// exactlyOneOf returns a config validator which checks that exactly one of the root attributes is configured.
+func exactlyOneOf(names ...string) exactlyOneOfValidator {
+	paths := make([]path.Path, 0, len(names))
+	for _, name := range names {
+		paths = append(paths, path.Root(name))
+	}
+	return exactlyOneOfValidator{paths: paths}
+}
+
+func (v exactlyOneOfValidator) Description(_ context.Context) string {
+	names := make([]string, 0, len(v.paths))
+	for _, p := range v.paths {
+		names = append(names, p.String())
+	}
+	return fmt.Sprintf("Exactly one of these attributes must be configured: [%s]", strings.Join(names, ", "))
+}
+
+func (v exactlyOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v exactlyOneOfValidator) ValidateDataSource(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, req.Config)...)
+}
+
+func (v exactlyOneOfValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, req.Config)...)
+}
+
+func (v exactlyOneOfValidator) validate(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+	configured := 0
+	for _, p := range v.paths {
+		var value attr.Value
+		diags.Append(config.GetAttribute(ctx, p, &value)...)
+		if diags.HasError() {
+			return diags
+		}
+		// unknown values are checked again when they are known
+		if value.IsUnknown() {
+			return diags
+		}
+		if !value.IsNull() {
+			configured++
+		}
+	}
+	if configured != 1 {
+		diags.AddAttributeError(v.paths[0], "Invalid Attribute Combination", v.Description(ctx))
+	}
+	return diags
+}
+
+// durationValidator checks that a string attribute is a duration accepted by time.ParseDuration, e.g. 30m.
+type durationValidator struct{}
+
+var _ validator.String = durationValidator{}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a duration, e.g. 30m or 1h30m"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || d < 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Duration", fmt.Sprintf("%s, got: %q", v.Description(ctx), req.ConfigValue.ValueString()))
+	}
+}
+
+// privateIPv4Ranges are the RFC 1918 private address ranges.
+var privateIPv4Ranges = []*net.IPNet{
+	mustParseCIDR("10.0.0.0/8"),
+	mustParseCIDR("172.16.0.0/12"),
+	mustParseCIDR("192.168.0.0/16"),
+}
+
+func mustParseCIDR(s string) *net.IPNet {
+	_, n, err := net.ParseCIDR(s)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// validateCIDR checks that value is an IPv4 CIDR block with a prefix length between minPrefix and maxPrefix, and
+// without host bits set.
+func validateCIDR(value string, minPrefix int, maxPrefix int) error {
+	ip, network, err := net.ParseCIDR(value)
+	if err != nil || ip.To4() == nil {
+		return fmt.Errorf("%q is not a valid IPv4 CIDR block, e.g. 172.16.0.0/21", value)
+	}
+	if !ip.Equal(network.IP) {
+		return fmt.Errorf("%q has host bits set, did you mean %q?", value, network.String())
+	}
+	prefix, _ := network.Mask.Size()
+	if prefix < minPrefix || prefix > maxPrefix {
+		return fmt.Errorf("the prefix length of %q must be between /%d and /%d", value, minPrefix, maxPrefix)
+	}
+	return nil
+}
+
+// validatePrivateCIDR checks value with validateCIDR, and that it is in an RFC 1918 private range.
+func validatePrivateCIDR(value string, minPrefix int, maxPrefix int) error {
+	if err := validateCIDR(value, minPrefix, maxPrefix); err != nil {
+		return err
+	}
+	_, network, _ := net.ParseCIDR(value)
+	prefix, _ := network.Mask.Size()
+	for _, private := range privateIPv4Ranges {
+		privatePrefix, _ := private.Mask.Size()
+		if private.Contains(network.IP) && prefix >= privatePrefix {
+			return nil
+		}
+	}
+	return fmt.Errorf("%q must be in one of the private ranges 10.0.0.0/8, 172.16.0.0/12 and 192.168.0.0/16", value)
+}
+
+// cidrsOverlap reports whether the two CIDR blocks share any address.
+func cidrsOverlap(a string, b string) (bool, error) {
+	_, x, err := net.ParseCIDR(a)
+	if err != nil {
+		return false, err
+	}
+	_, y, err := net.ParseCIDR(b)
+	if err != nil {
+		return false, err
+	}
+	return x.Contains(y.IP) || y.Contains(x.IP), nil
+}
+
+// cidrValidator checks a string attribute with validatePrivateCIDR if private is set, or with validateCIDR otherwise.
+type cidrValidator struct {
+	minPrefix int
+	maxPrefix int
+	private   bool
+}
+
+var _ validator.String = cidrValidator{}
+
+func (v cidrValidator) Description(_ context.Context) string {
+	if v.private {
+		return fmt.Sprintf("value must be an IPv4 CIDR block in an RFC 1918 private range, with a prefix length between /%d and /%d", v.minPrefix, v.maxPrefix)
+	}
+	return fmt.Sprintf("value must be an IPv4 CIDR block with a prefix length between /%d and /%d", v.minPrefix, v.maxPrefix)
+}
+
+func (v cidrValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cidrValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	validate := validateCIDR
+	if v.private {
+		validate = validatePrivateCIDR
+	}
+	if err := validate(req.ConfigValue.ValueString(), v.minPrefix, v.maxPrefix); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid CIDR Block", err.Error())
+	}
+}
+
+// atLeastInt32Validator checks that an int32 attribute is not less than min.
+type atLeastInt32Validator struct {
+	min int32
+}
+
+var _ validator.Int32 = atLeastInt32Validator{}
+
+func (v atLeastInt32Validator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be at least %d", v.min)
+}
+
+func (v atLeastInt32Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v atLeastInt32Validator) ValidateInt32(ctx context.Context, req validator.Int32Request, resp *validator.Int32Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if req.ConfigValue.ValueInt32() < v.min {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value", fmt.Sprintf("%s, got: %d", v.Description(ctx), req.ConfigValue.ValueInt32()))
+	}
+}
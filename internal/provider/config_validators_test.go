@@ -0,0 +1,66 @@
+package provider
+
+import "testing"
+
+func TestUTValidateCIDR(t *testing.T) {
+	tests := []struct {
+		value   string
+		wantErr bool
+	}{
+		{value: "172.16.0.0/21"},
+		{value: "10.250.0.0/16"},
+		{value: "192.168.100.0/23"},
+		{value: "172.16.0.0", wantErr: true},
+		{value: "fd00::/16", wantErr: true},
+		{value: "172.16.0.1/21", wantErr: true},
+		{value: "10.0.0.0/8", wantErr: true},
+		{value: "10.0.0.0/24", wantErr: true},
+		{value: "8.8.0.0/16", wantErr: true},
+		{value: "172.32.0.0/16", wantErr: true},
+	}
+	for _, tt := range tests {
+		err := validatePrivateCIDR(tt.value, dedicatedNetworkContainerMinCidrPrefix, dedicatedNetworkContainerMaxCidrPrefix)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("validatePrivateCIDR(%q) = %v, expected error %v", tt.value, err, tt.wantErr)
+		}
+	}
+
+	// customer VPCs may use any range, e.g. the shared address space of RFC 6598
+	customerVpcTests := []struct {
+		value   string
+		wantErr bool
+	}{
+		{value: "100.64.0.0/10"},
+		{value: "8.8.0.0/16"},
+		{value: "10.0.0.0/8"},
+		{value: "100.64.0.1/10", wantErr: true},
+		{value: "100.64.0.0/30", wantErr: true},
+	}
+	for _, tt := range customerVpcTests {
+		err := validateCIDR(tt.value, customerVpcMinCidrPrefix, customerVpcMaxCidrPrefix)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("validateCIDR(%q) = %v, expected error %v", tt.value, err, tt.wantErr)
+		}
+	}
+}
+
+func TestUTCidrsOverlap(t *testing.T) {
+	tests := []struct {
+		a, b    string
+		overlap bool
+	}{
+		{a: "172.16.0.0/21", b: "172.16.4.0/22", overlap: true},
+		{a: "172.16.4.0/22", b: "172.16.0.0/21", overlap: true},
+		{a: "172.16.0.0/21", b: "172.16.8.0/21"},
+		{a: "10.0.0.0/8", b: "192.168.0.0/16"},
+	}
+	for _, tt := range tests {
+		overlap, err := cidrsOverlap(tt.a, tt.b)
+		if err != nil || overlap != tt.overlap {
+			t.Errorf("cidrsOverlap(%q, %q) = %v, %v, expected %v", tt.a, tt.b, overlap, err, tt.overlap)
+		}
+	}
+	if _, err := cidrsOverlap("172.16.0.0", "172.16.0.0/21"); err == nil {
+		t.Errorf("expected error for an invalid CIDR block")
+	}
+}
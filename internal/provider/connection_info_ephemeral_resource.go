@@ -0,0 +1,303 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// CABundleSystem means the server certificate is signed by a public CA, so the CA bundle of the system is used.
+	CABundleSystem = "system"
+	// CABundleCluster means the CA certificate of the cluster must be downloaded from the TiDB Cloud console.
+	CABundleCluster = "cluster"
+
+	// ClusterCATLSConfig is the name of the TLS config used in the DSN of the endpoints verified with the CA
+	// certificate of the cluster. It must be registered with mysql.RegisterTLSConfig of the Go MySQL driver.
+	ClusterCATLSConfig = "tidbcloud"
+)
+
+type connectionInfoData struct {
+	ClusterId    types.String `tfsdk:"cluster_id"`
+	BranchId     types.String `tfsdk:"branch_id"`
+	EndpointType types.String `tfsdk:"endpoint_type"`
+	UserName     types.String `tfsdk:"user_name"`
+	Password     types.String `tfsdk:"password"`
+	Database     types.String `tfsdk:"database"`
+	TLS          types.Bool   `tfsdk:"tls"`
+	CAPath       types.String `tfsdk:"ca_path"`
+	Host         types.String `tfsdk:"host"`
+	Port         types.Int32  `tfsdk:"port"`
+	DSN          types.String `tfsdk:"dsn"`
+	JDBCURL      types.String `tfsdk:"jdbc_url"`
+	MySQLCommand types.String `tfsdk:"mysql_command"`
+	TLSRequired  types.Bool   `tfsdk:"tls_required"`
+	CABundle     types.String `tfsdk:"ca_bundle"`
+	TiProxy      types.Bool   `tfsdk:"tiproxy"`
+
+	PrivateLinkService types.String `tfsdk:"private_link_service"`
+}
+
+type connectionInfoEphemeralResource struct {
+	provider *tidbcloudProvider
+}
+
+var _ ephemeral.EphemeralResourceWithConfigure = &connectionInfoEphemeralResource{}
+
+func NewConnectionInfoEphemeralResource() ephemeral.EphemeralResource {
+	return &connectionInfoEphemeralResource{}
+}
+
+func (r *connectionInfoEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connection_info"
+}
+
+func (r *connectionInfoEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	var ok bool
+	if r.provider, ok = req.ProviderData.(*tidbcloudProvider); !ok {
+		resp.Diagnostics.AddError("Internal provider error",
+			fmt.Sprintf("Error in Configure: expected %T but got %T", tidbcloudProvider{}, req.ProviderData))
+	}
+}
+
+func (r *connectionInfoEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resolves the endpoint of a serverless cluster, a serverless branch or a dedicated cluster and returns ready-to-use connection strings. Nothing is saved in the state.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the serverless or dedicated cluster.",
+				Required:            true,
+			},
+			"branch_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the branch. Only available for serverless cluster.",
+				Optional:            true,
+			},
+			"endpoint_type": schema.StringAttribute{
+				MarkdownDescription: "The endpoint to connect to, available values [public, private_link, vpc_peering]. private_link is the private link endpoint of serverless cluster or the private endpoint of dedicated cluster, which is reached through a private link endpoint in your VPC, see private_link_service. private is the former name of private_link. vpc_peering is only available for dedicated cluster. Default is public.",
+				Optional:            true,
+			},
+			"user_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the user. Default is the root user, which is <user_prefix>.root for serverless cluster.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password of the user. It is only included in dsn and jdbc_url.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"database": schema.StringAttribute{
+				MarkdownDescription: "The default database.",
+				Optional:            true,
+			},
+			"tls": schema.BoolAttribute{
+				MarkdownDescription: "Whether to connect with TLS. Default is true. TLS is always used for serverless cluster.",
+				Optional:            true,
+			},
+			"ca_path": schema.StringAttribute{
+				MarkdownDescription: "The path of the CA bundle on the client, used in mysql_command.",
+				Optional:            true,
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "The host of the endpoint.",
+				Computed:            true,
+			},
+			"port": schema.Int32Attribute{
+				MarkdownDescription: "The port of the endpoint.",
+				Computed:            true,
+			},
+			"dsn": schema.StringAttribute{
+				MarkdownDescription: "The DSN in the format of the Go MySQL driver, e.g. user:password@tcp(host:port)/database?tls=true. When ca_bundle is cluster, the DSN uses tls=tidbcloud instead, a custom TLS config which must be registered with mysql.RegisterTLSConfig and the CA certificate of the cluster before connecting.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"jdbc_url": schema.StringAttribute{
+				MarkdownDescription: "The JDBC URL of MySQL Connector/J.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"mysql_command": schema.StringAttribute{
+				MarkdownDescription: "The mysql CLI command, the password is prompted.",
+				Computed:            true,
+			},
+			"tls_required": schema.BoolAttribute{
+				MarkdownDescription: "Whether the endpoint requires TLS.",
+				Computed:            true,
+			},
+			"ca_bundle": schema.StringAttribute{
+				MarkdownDescription: "The CA bundle to verify the server certificate. system means the CA bundle of the system, cluster means the CA certificate of the cluster which can be downloaded from the TiDB Cloud console.",
+				Computed:            true,
+			},
+			"tiproxy": schema.BoolAttribute{
+				MarkdownDescription: "Whether the connections go through TiProxy. Only available for dedicated cluster.",
+				Computed:            true,
+			},
+			"private_link_service": schema.StringAttribute{
+				MarkdownDescription: "The service the private link endpoint in your VPC connects to, i.e. the AWS endpoint service name, the GCP service attachment or the Azure private link service alias. Only set for the private_link endpoint.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *connectionInfoEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if !r.provider.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	var data connectionInfoData
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpointType := EndpointTypePublic
+	if IsKnown(data.EndpointType) {
+		endpointType = data.EndpointType.ValueString()
+	}
+
+	tflog.Trace(ctx, "open connection_info_ephemeral_resource")
+	endpoint, err := resolveClusterEndpoint(ctx, r.provider, data.ClusterId.ValueString(), data.BranchId.ValueString(), endpointType)
+	if err != nil {
+		resp.Diagnostics.AddError("Open Error", fmt.Sprintf("Unable to resolve the endpoint of cluster %s, got error: %s", data.ClusterId.ValueString(), err))
+		return
+	}
+
+	userName := data.UserName.ValueString()
+	if userName == "" {
+		userName = "root"
+		if endpoint.UserPrefix != "" {
+			userName = endpoint.UserPrefix + ".root"
+		}
+	}
+	tls := true
+	if IsKnown(data.TLS) {
+		tls = data.TLS.ValueBool()
+	}
+	if endpoint.Serverless && !tls {
+		resp.Diagnostics.AddAttributeWarning(path.Root("tls"), "TLS is required",
+			"Serverless cluster only accepts TLS connections, tls is ignored.")
+		tls = true
+	}
+
+	conn := connectionParams{
+		Host:     endpoint.Host,
+		Port:     endpoint.Port,
+		User:     userName,
+		Password: data.Password.ValueString(),
+		Database: data.Database.ValueString(),
+		TLS:      tls,
+		CAPath:   data.CAPath.ValueString(),
+	}
+	if !endpoint.Serverless {
+		// the server certificate of dedicated cluster is signed by the CA of the cluster, not by a public CA
+		conn.TLSConfig = ClusterCATLSConfig
+	}
+	data.UserName = types.StringValue(userName)
+	data.Host = types.StringValue(endpoint.Host)
+	data.Port = types.Int32Value(endpoint.Port)
+	data.DSN = types.StringValue(conn.DSN())
+	data.JDBCURL = types.StringValue(conn.JDBCURL())
+	data.MySQLCommand = types.StringValue(conn.MySQLCommand())
+	data.TLSRequired = types.BoolValue(endpoint.Serverless)
+	if endpoint.Serverless {
+		data.CABundle = types.StringValue(CABundleSystem)
+	} else {
+		data.CABundle = types.StringValue(CABundleCluster)
+	}
+	data.TiProxy = types.BoolValue(endpoint.TiProxy)
+	data.PrivateLinkService = types.StringNull()
+	if endpoint.PrivateLinkService != "" {
+		data.PrivateLinkService = types.StringValue(endpoint.PrivateLinkService)
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// connectionParams builds the connection strings of a TiDB endpoint.
+type connectionParams struct {
+	Host     string
+	Port     int32
+	User     string
+	Password string
+	Database string
+	TLS      bool
+	CAPath   string
+	// TLSConfig is the TLS config of the Go MySQL driver used in the DSN when TLS is enabled, default is true,
+	// i.e. the server certificate is verified with the CA bundle of the system.
+	TLSConfig string
+}
+
+// DSN returns the DSN in the format of the Go MySQL driver.
+func (c connectionParams) DSN() string {
+	var sb strings.Builder
+	sb.WriteString(c.User)
+	if c.Password != "" {
+		sb.WriteString(":" + c.Password)
+	}
+	sb.WriteString(fmt.Sprintf("@tcp(%s)/%s", c.address(), c.Database))
+	if c.TLS {
+		tlsConfig := c.TLSConfig
+		if tlsConfig == "" {
+			tlsConfig = "true"
+		}
+		sb.WriteString("?tls=" + url.QueryEscape(tlsConfig))
+	}
+	return sb.String()
+}
+
+// JDBCURL returns the JDBC URL of MySQL Connector/J.
+func (c connectionParams) JDBCURL() string {
+	query := url.Values{}
+	query.Set("user", c.User)
+	if c.Password != "" {
+		query.Set("password", c.Password)
+	}
+	if c.TLS {
+		query.Set("sslMode", "VERIFY_IDENTITY")
+		query.Set("enabledTLSProtocols", "TLSv1.2,TLSv1.3")
+	}
+	return fmt.Sprintf("jdbc:mysql://%s/%s?%s", c.address(), url.PathEscape(c.Database), query.Encode())
+}
+
+// MySQLCommand returns the mysql CLI command. The password is never included, it is prompted.
+func (c connectionParams) MySQLCommand() string {
+	args := []string{"mysql", "-u", shellQuote(c.User), "-h", c.Host, "-P", strconv.Itoa(int(c.Port))}
+	if c.Database != "" {
+		args = append(args, "-D", shellQuote(c.Database))
+	}
+	if c.TLS {
+		args = append(args, "--ssl-mode=VERIFY_IDENTITY")
+		if c.CAPath != "" {
+			args = append(args, "--ssl-ca="+shellQuote(c.CAPath))
+		}
+	}
+	args = append(args, "-p")
+	return strings.Join(args, " ")
+}
+
+func (c connectionParams) address() string {
+	return net.JoinHostPort(c.Host, strconv.Itoa(int(c.Port)))
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
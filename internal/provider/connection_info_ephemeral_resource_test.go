@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"testing"
+
+	clusterV1beta1 "github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/serverless/cluster"
+)
+
+func TestUTConnectionParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		conn    connectionParams
+		dsn     string
+		jdbcURL string
+		command string
+	}{
+		{
+			name: "serverless",
+			conn: connectionParams{
+				Host:     "gateway01.us-east-1.prod.aws.tidbcloud.com",
+				Port:     4000,
+				User:     "prefix.root",
+				Password: "p@ss",
+				Database: "test",
+				TLS:      true,
+				CAPath:   "/etc/ssl/cert.pem",
+			},
+			dsn:     "prefix.root:p@ss@tcp(gateway01.us-east-1.prod.aws.tidbcloud.com:4000)/test?tls=true",
+			jdbcURL: "jdbc:mysql://gateway01.us-east-1.prod.aws.tidbcloud.com:4000/test?enabledTLSProtocols=TLSv1.2%2CTLSv1.3&password=p%40ss&sslMode=VERIFY_IDENTITY&user=prefix.root",
+			command: "mysql -u 'prefix.root' -h gateway01.us-east-1.prod.aws.tidbcloud.com -P 4000 -D 'test' --ssl-mode=VERIFY_IDENTITY --ssl-ca='/etc/ssl/cert.pem' -p",
+		},
+		{
+			name: "dedicated with the cluster ca",
+			conn: connectionParams{
+				Host:      "tidb.abc.clusters.tidb-cloud.com",
+				Port:      4000,
+				User:      "root",
+				TLS:       true,
+				TLSConfig: ClusterCATLSConfig,
+				CAPath:    "/tmp/ca.pem",
+			},
+			dsn:     "root@tcp(tidb.abc.clusters.tidb-cloud.com:4000)/?tls=tidbcloud",
+			jdbcURL: "jdbc:mysql://tidb.abc.clusters.tidb-cloud.com:4000/?enabledTLSProtocols=TLSv1.2%2CTLSv1.3&sslMode=VERIFY_IDENTITY&user=root",
+			command: "mysql -u 'root' -h tidb.abc.clusters.tidb-cloud.com -P 4000 --ssl-mode=VERIFY_IDENTITY --ssl-ca='/tmp/ca.pem' -p",
+		},
+		{
+			name: "dedicated without tls",
+			conn: connectionParams{
+				Host: "private-tidb.example.com",
+				Port: 4000,
+				User: "root",
+			},
+			dsn:     "root@tcp(private-tidb.example.com:4000)/",
+			jdbcURL: "jdbc:mysql://private-tidb.example.com:4000/?user=root",
+			command: "mysql -u 'root' -h private-tidb.example.com -P 4000 -p",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.conn.DSN(); got != tt.dsn {
+				t.Errorf("DSN() = %q, want %q", got, tt.dsn)
+			}
+			if got := tt.conn.JDBCURL(); got != tt.jdbcURL {
+				t.Errorf("JDBCURL() = %q, want %q", got, tt.jdbcURL)
+			}
+			if got := tt.conn.MySQLCommand(); got != tt.command {
+				t.Errorf("MySQLCommand() = %q, want %q", got, tt.command)
+			}
+		})
+	}
+}
+
+func TestUTServerlessClusterEndpoint(t *testing.T) {
+	tests := []struct {
+		name         string
+		cluster      string
+		endpointType string
+		host         string
+		port         int32
+		service      string
+		wantErr      bool
+	}{
+		{
+			name:         "public",
+			cluster:      `{"displayName": "c", "region": {"name": "regions/aws-us-east-1"}, "userPrefix": "prefix", "endpoints": {"public": {"host": "gateway01.aws.tidbcloud.com", "port": 4000}}}`,
+			endpointType: EndpointTypePublic,
+			host:         "gateway01.aws.tidbcloud.com",
+			port:         4000,
+		},
+		{
+			name:         "public without port",
+			cluster:      `{"displayName": "c", "region": {"name": "regions/aws-us-east-1"}, "endpoints": {"public": {"host": "gateway01.aws.tidbcloud.com"}}}`,
+			endpointType: EndpointTypePublic,
+			host:         "gateway01.aws.tidbcloud.com",
+		},
+		{
+			name:         "public disabled",
+			cluster:      `{"displayName": "c", "region": {"name": "regions/aws-us-east-1"}, "endpoints": {"public": {"host": "gateway01.aws.tidbcloud.com", "port": 4000, "disabled": true}}}`,
+			endpointType: EndpointTypePublic,
+			wantErr:      true,
+		},
+		{
+			name:         "private link",
+			cluster:      `{"displayName": "c", "region": {"name": "regions/aws-us-east-1"}, "endpoints": {"private": {"host": "gateway01-privatelink.aws.tidbcloud.com", "port": 4000, "aws": {"serviceName": "com.amazonaws.vpce.us-east-1.vpce-svc-1"}}}}`,
+			endpointType: EndpointTypePrivateLink,
+			host:         "gateway01-privatelink.aws.tidbcloud.com",
+			port:         4000,
+			service:      "com.amazonaws.vpce.us-east-1.vpce-svc-1",
+		},
+		{
+			name:         "private link by the former name",
+			cluster:      `{"displayName": "c", "region": {"name": "regions/aws-us-east-1"}, "endpoints": {"private": {"host": "gateway01-privatelink.aws.tidbcloud.com", "port": 4000}}}`,
+			endpointType: EndpointTypePrivate,
+			host:         "gateway01-privatelink.aws.tidbcloud.com",
+			port:         4000,
+		},
+		{
+			name:         "no endpoints and no cluster id",
+			cluster:      `{"displayName": "c", "region": {"name": "regions/aws-us-east-1"}}`,
+			endpointType: EndpointTypePrivateLink,
+			wantErr:      true,
+		},
+		{
+			name:         "vpc peering",
+			cluster:      `{"displayName": "c", "region": {"name": "regions/aws-us-east-1"}, "endpoints": {"public": {"host": "gateway01.aws.tidbcloud.com", "port": 4000}}}`,
+			endpointType: EndpointTypeVpcPeering,
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := clusterV1beta1.TidbCloudOpenApiserverlessv1beta1Cluster{}
+			if err := cluster.UnmarshalJSON([]byte(tt.cluster)); err != nil {
+				t.Fatalf("failed to unmarshal cluster: %v", err)
+			}
+			endpoint, err := serverlessClusterEndpoint(&cluster, tt.endpointType)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("serverlessClusterEndpoint() error = %v, expected error %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if endpoint.Host != tt.host || endpoint.Port != tt.port || endpoint.PrivateLinkService != tt.service || !endpoint.Serverless {
+				t.Errorf("serverlessClusterEndpoint() = %+v, expected %s:%d with service %q", endpoint, tt.host, tt.port, tt.service)
+			}
+		})
+	}
+}
@@ -66,7 +66,7 @@ func (d *dedicatedCloudProvidersDataSource) Read(ctx context.Context, req dataso
 	}
 
 	tflog.Trace(ctx, "read regions data source")
-	cloudProviders, err := d.provider.DedicatedClient.ListCloudProviders(ctx, data.ProjectId.ValueString())
+	cloudProviders, err := d.provider.listDedicatedCloudProviders(ctx, data.ProjectId.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to call ListCloudProviders, got error: %s", err))
 		return
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/dedicated"
 )
 
 type dedicatedClusterDataSourceData struct {
@@ -33,6 +34,7 @@ type dedicatedClusterDataSourceData struct {
 }
 
 var _ datasource.DataSource = &dedicatedClusterDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &dedicatedClusterDataSource{}
 
 type dedicatedClusterDataSource struct {
 	provider *tidbcloudProvider
@@ -62,15 +64,18 @@ func (d *dedicatedClusterDataSource) Schema(_ context.Context, _ datasource.Sche
 		MarkdownDescription: "dedicated cluster data source",
 		Attributes: map[string]schema.Attribute{
 			"project_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the project.",
+				MarkdownDescription: "The ID of the project. Used to scope the lookup by display_name. If not set, the clusters of all the projects are searched.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"cluster_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the cluster.",
-				Required:            true,
+				MarkdownDescription: "The ID of the cluster. Exactly one of cluster_id and display_name must be set.",
+				Optional:            true,
+				Computed:            true,
 			},
 			"display_name": schema.StringAttribute{
-				MarkdownDescription: "The name of the cluster.",
+				MarkdownDescription: "The name of the cluster. Exactly one of cluster_id and display_name must be set. An error is returned if no cluster or more than one cluster has the name.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"cloud_provider": schema.StringAttribute{
@@ -174,6 +179,10 @@ func (d *dedicatedClusterDataSource) Schema(_ context.Context, _ datasource.Sche
 						MarkdownDescription: "Settings for TiProxy nodes.",
 						Computed:            true,
 						Attributes: map[string]schema.Attribute{
+							"enabled": schema.BoolAttribute{
+								MarkdownDescription: "Whether TiProxy is enabled.",
+								Computed:            true,
+							},
 							"node_spec_key": schema.StringAttribute{
 								MarkdownDescription: "The key of the node spec.",
 								Computed:            true,
@@ -273,6 +282,12 @@ func (d *dedicatedClusterDataSource) Schema(_ context.Context, _ datasource.Sche
 	}
 }
 
+func (d *dedicatedClusterDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		exactlyOneOf("cluster_id", "display_name"),
+	}
+}
+
 func (d *dedicatedClusterDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data dedicatedClusterDataSourceData
 	diags := req.Config.Get(ctx, &data)
@@ -282,7 +297,24 @@ func (d *dedicatedClusterDataSource) Read(ctx context.Context, req datasource.Re
 	}
 
 	tflog.Trace(ctx, "read dedicated cluster data source")
-	cluster, err := d.provider.DedicatedClient.GetCluster(ctx, data.ClusterId.ValueString())
+	clusterId := data.ClusterId.ValueString()
+	if IsKnown(data.DisplayName) {
+		projectId := data.ProjectId.ValueString()
+		clusters, err := (&dedicatedClustersDataSource{provider: d.provider}).retrieveClusters(ctx, projectId)
+		if err != nil {
+			resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to call ListClusters, got error: %s", err))
+			return
+		}
+		clusterId, err = resolveDisplayName(clusters, data.DisplayName.ValueString(), "dedicated cluster", projectScope(projectId),
+			func(c dedicated.TidbCloudOpenApidedicatedv1beta1Cluster) (string, string) {
+				return c.DisplayName, *c.ClusterId
+			})
+		if err != nil {
+			resp.Diagnostics.AddError("Read Error", err.Error())
+			return
+		}
+	}
+	cluster, err := d.provider.DedicatedClient.GetCluster(ctx, clusterId)
 	if err != nil {
 		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to call GetCluster, got error: %s", err))
 		return
@@ -334,13 +366,8 @@ func (d *dedicatedClusterDataSource) Read(ctx context.Context, req datasource.Re
 			diags.Append(listDiags...)
 
 			defaultTiProxySetting := tiProxySetting{}
-			if group.TiproxySetting != nil {
-				defaultTiProxySetting = tiProxySetting{
-					NodeSpecKey:         types.StringValue(group.TiproxySetting.NodeSpecKey),
-					NodeSpecVersion:     types.StringValue(*group.TiproxySetting.NodeSpecVersion),
-					NodeCount:           types.Int32Value(*group.TiproxySetting.NodeCount.Get()),
-					NodeSpecDisplayName: types.StringValue(*group.TiproxySetting.NodeSpecDisplayName),
-				}
+			if s := convertTiProxySetting(group.TiproxySetting); s != nil {
+				defaultTiProxySetting = *s
 			}
 			publicEndpointSetting, err := d.provider.DedicatedClient.GetPublicEndpoint(ctx, data.ClusterId.ValueString(), *group.TidbNodeGroupId)
 			if err != nil {
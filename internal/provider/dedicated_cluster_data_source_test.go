@@ -1,6 +1,9 @@
 package provider
 
 import (
+	"fmt"
+	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -31,7 +34,7 @@ func TestUTDedicatedClusterDataSource(t *testing.T) {
 
 	ctrl := gomock.NewController(t)
 	s := mockClient.NewMockTiDBCloudDedicatedClient(ctrl)
-	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string) (tidbcloud.TiDBCloudDedicatedClient, error) {
+	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudDedicatedClient, error) {
 		return s, nil
 	})()
 
@@ -99,3 +102,60 @@ data "tidbcloud_dedicated_cluster" "test" {
 }
 `
 }
+
+func TestUTDedicatedClusterDataSourceByDisplayName(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudDedicatedClient(ctrl)
+	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudDedicatedClient, error) {
+		return s, nil
+	})()
+
+	state := string(dedicated.COMMONV1BETA1CLUSTERSTATE_ACTIVE)
+	var clusters []dedicated.TidbCloudOpenApidedicatedv1beta1Cluster
+	for _, c := range []struct{ id, name string }{{"cluster_id", "test-tf"}, {"dup_1", "dup"}, {"dup_2", "dup"}} {
+		cluster := dedicated.TidbCloudOpenApidedicatedv1beta1Cluster{}
+		cluster.UnmarshalJSON([]byte(testUTTidbCloudOpenApidedicatedv1beta1Cluster(c.id, c.name, state, "2C4G", "2 vCPU, 4 GiB beta")))
+		clusters = append(clusters, cluster)
+	}
+	listClustersResp := dedicated.TidbCloudOpenApidedicatedv1beta1ListClustersResponse{Clusters: clusters}
+	publicEndpointResp := dedicated.V1beta1PublicEndpointSetting{}
+	publicEndpointResp.UnmarshalJSON([]byte(testUTV1beta1PublicEndpointSetting()))
+
+	s.EXPECT().ListClusters(gomock.Any(), gomock.Any(), gomock.Any(), nil).Return(&listClustersResp, nil).AnyTimes()
+	s.EXPECT().GetCluster(gomock.Any(), "cluster_id").Return(&clusters[0], nil).AnyTimes()
+	s.EXPECT().GetPublicEndpoint(gomock.Any(), gomock.Any(), gomock.Any()).Return(&publicEndpointResp, nil).AnyTimes()
+
+	dedicatedClusterDataSourceName := "data.tidbcloud_dedicated_cluster.test"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUTDedicatedClusterDataSourceByDisplayNameConfig("test-tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dedicatedClusterDataSourceName, "cluster_id", "cluster_id"),
+					resource.TestCheckResourceAttr(dedicatedClusterDataSourceName, "display_name", "test-tf"),
+				),
+			},
+			{
+				Config:      testUTDedicatedClusterDataSourceByDisplayNameConfig("not-exist"),
+				ExpectError: regexp.MustCompile(`no dedicated cluster named "not-exist" found in any project`),
+			},
+			{
+				Config:      testUTDedicatedClusterDataSourceByDisplayNameConfig("dup"),
+				ExpectError: regexp.MustCompile(`2 dedicated clusters named "dup" found in any project: dup_1, dup_2`),
+			},
+		},
+	})
+}
+
+func testUTDedicatedClusterDataSourceByDisplayNameConfig(displayName string) string {
+	return fmt.Sprintf(`
+data "tidbcloud_dedicated_cluster" "test" {
+	display_name = "%s"
+}
+`, displayName)
+}
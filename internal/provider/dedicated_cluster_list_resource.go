@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/dedicated"
+)
+
+type dedicatedClusterListConfig struct {
+	ProjectId types.String `tfsdk:"project_id"`
+}
+
+type dedicatedClusterListResource struct {
+	provider *tidbcloudProvider
+}
+
+var _ list.ListResourceWithConfigure = &dedicatedClusterListResource{}
+
+func NewDedicatedClusterListResource() list.ListResource {
+	return &dedicatedClusterListResource{}
+}
+
+func (r *dedicatedClusterListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dedicated_cluster"
+}
+
+func (r *dedicatedClusterListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	var ok bool
+	if r.provider, ok = req.ProviderData.(*tidbcloudProvider); !ok {
+		resp.Diagnostics.AddError("Internal provider error",
+			fmt.Sprintf("Error in Configure: expected %T but got %T", tidbcloudProvider{}, req.ProviderData))
+	}
+}
+
+func (r *dedicatedClusterListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the dedicated clusters.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project. If not set, the clusters of all the projects are listed.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *dedicatedClusterListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config dedicatedClusterListConfig
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Trace(ctx, "list dedicated_cluster_resource")
+	clusters, err := (&dedicatedClustersDataSource{provider: r.provider}).retrieveClusters(ctx, config.ProjectId.ValueString())
+	if err != nil {
+		stream.Results = listError("List Error", fmt.Sprintf("Unable to call ListClusters, got error: %s", err))
+		return
+	}
+
+	stream.Results = listResults(ctx, req, clusters, func(cluster dedicated.TidbCloudOpenApidedicatedv1beta1Cluster, result *list.ListResult) {
+		result.DisplayName = cluster.DisplayName
+		result.Diagnostics.Append(result.Identity.Set(ctx, dedicatedClusterResourceIdentity{ClusterId: types.StringValue(*cluster.ClusterId)})...)
+		if !req.IncludeResource {
+			return
+		}
+		var data dedicatedClusterResourceData
+		result.Diagnostics.Append(refreshDedicatedClusterResourceData(ctx, &cluster, &data)...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+		result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+	})
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	mockClient "github.com/tidbcloud/terraform-provider-tidbcloud/mock"
+	"github.com/tidbcloud/terraform-provider-tidbcloud/tidbcloud"
+	"github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/dedicated"
+)
+
+func TestUTDedicatedClusterListResource(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudDedicatedClient(ctrl)
+	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudDedicatedClient, error) {
+		return s, nil
+	})()
+
+	var resp dedicated.TidbCloudOpenApidedicatedv1beta1ListClustersResponse
+	resp.UnmarshalJSON([]byte(testUTTidbCloudOpenApidedicatedv1beta1ListClustersResponse))
+	publicEndpointResp := dedicated.V1beta1PublicEndpointSetting{}
+	publicEndpointResp.UnmarshalJSON([]byte(testUTV1beta1PublicEndpointSetting()))
+
+	s.EXPECT().ListClusters(gomock.Any(), gomock.Any(), gomock.Any(), nil).Return(&resp, nil).AnyTimes()
+	s.EXPECT().GetPublicEndpoint(gomock.Any(), gomock.Any(), gomock.Any()).Return(&publicEndpointResp, nil).AnyTimes()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		PreCheck:   func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUTDedicatedClustersConfig,
+			},
+			{
+				Query: true,
+				Config: `
+provider "tidbcloud" {}
+
+list "tidbcloud_dedicated_cluster" "test" {
+  provider = tidbcloud
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("tidbcloud_dedicated_cluster.test", 2),
+					querycheck.ExpectIdentity("tidbcloud_dedicated_cluster.test", map[string]knownvalue.Check{
+						"cluster_id": knownvalue.StringExact("1067097xxx"),
+					}),
+					querycheck.ExpectIdentity("tidbcloud_dedicated_cluster.test", map[string]knownvalue.Check{
+						"cluster_id": knownvalue.StringExact("10659xxxxx"),
+					}),
+				},
+			},
+		},
+	})
+}
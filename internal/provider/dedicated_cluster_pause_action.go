@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/tidbcloud/terraform-provider-tidbcloud/tidbcloud"
+	"github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/dedicated"
+)
+
+type dedicatedClusterPauseActionData struct {
+	ClusterId         types.String `tfsdk:"cluster_id"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
+}
+
+// dedicatedClusterPauseAction pauses a dedicated cluster, or resumes it if resume is true.
+type dedicatedClusterPauseAction struct {
+	provider *tidbcloudProvider
+	resume   bool
+}
+
+var _ action.ActionWithConfigure = &dedicatedClusterPauseAction{}
+
+func NewDedicatedClusterPauseAction() action.Action {
+	return &dedicatedClusterPauseAction{}
+}
+
+func NewDedicatedClusterResumeAction() action.Action {
+	return &dedicatedClusterPauseAction{resume: true}
+}
+
+func (a *dedicatedClusterPauseAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	if a.resume {
+		resp.TypeName = req.ProviderTypeName + "_dedicated_cluster_resume"
+	} else {
+		resp.TypeName = req.ProviderTypeName + "_dedicated_cluster_pause"
+	}
+}
+
+func (a *dedicatedClusterPauseAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	var ok bool
+	if a.provider, ok = req.ProviderData.(*tidbcloudProvider); !ok {
+		resp.Diagnostics.AddError("Internal provider error",
+			fmt.Sprintf("Error in Configure: expected %T but got %T", tidbcloudProvider{}, req.ProviderData))
+	}
+}
+
+func (a *dedicatedClusterPauseAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	description := "Pauses a dedicated cluster. The paused attribute of tidbcloud_dedicated_cluster is not changed."
+	waitDescription := "Whether to wait until the cluster is paused. Default is true."
+	if a.resume {
+		description = "Resumes a paused dedicated cluster. The paused attribute of tidbcloud_dedicated_cluster is not changed."
+		waitDescription = "Whether to wait until the cluster is active. Default is true."
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the cluster.",
+				Required:            true,
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				MarkdownDescription: waitDescription,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *dedicatedClusterPauseAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if !a.provider.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	var data dedicatedClusterPauseActionData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	clusterId := data.ClusterId.ValueString()
+
+	var pending []string
+	var target string
+	if a.resume {
+		tflog.Trace(ctx, "invoke dedicated_cluster_resume_action")
+		if _, err := a.provider.DedicatedClient.ResumeCluster(ctx, clusterId); err != nil {
+			resp.Diagnostics.AddError("Resume Error", fmt.Sprintf("Unable to call ResumeCluster, got error: %s", err))
+			return
+		}
+		pending = []string{string(dedicated.COMMONV1BETA1CLUSTERSTATE_PAUSED), string(dedicated.COMMONV1BETA1CLUSTERSTATE_RESUMING)}
+		target = string(dedicated.COMMONV1BETA1CLUSTERSTATE_ACTIVE)
+	} else {
+		tflog.Trace(ctx, "invoke dedicated_cluster_pause_action")
+		if _, err := a.provider.DedicatedClient.PauseCluster(ctx, clusterId); err != nil {
+			resp.Diagnostics.AddError("Pause Error", fmt.Sprintf("Unable to call PauseCluster, got error: %s", err))
+			return
+		}
+		pending = []string{string(dedicated.COMMONV1BETA1CLUSTERSTATE_ACTIVE), string(dedicated.COMMONV1BETA1CLUSTERSTATE_PAUSING)}
+		target = string(dedicated.COMMONV1BETA1CLUSTERSTATE_PAUSED)
+	}
+
+	if IsKnown(data.WaitForCompletion) && !data.WaitForCompletion.ValueBool() {
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Waiting for dedicated cluster %s to be %s", clusterId, target)})
+	if _, err := waitDedicatedClusterState(ctx, clusterUpdateTimeout, clusterUpdateInterval, clusterId, pending, target, a.provider.DedicatedClient); err != nil {
+		resp.Diagnostics.AddError("Wait Error", fmt.Sprintf("Dedicated cluster %s is not %s, got error: %s", clusterId, target, err))
+	}
+}
+
+// waitDedicatedClusterState waits until the dedicated cluster leaves the pending states for the target state.
+func waitDedicatedClusterState(ctx context.Context, timeout time.Duration, interval time.Duration, clusterId string, pending []string, target string,
+	client tidbcloud.TiDBCloudDedicatedClient) (*dedicated.TidbCloudOpenApidedicatedv1beta1Cluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      pending,
+		Target:       []string{target},
+		Timeout:      timeout,
+		MinTimeout:   500 * time.Millisecond,
+		PollInterval: interval,
+		Refresh:      dedicatedClusterStateRefreshFunc(ctx, clusterId, client),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dedicated.TidbCloudOpenApidedicatedv1beta1Cluster); ok {
+		return output, err
+	}
+	return nil, err
+}
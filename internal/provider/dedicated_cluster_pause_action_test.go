@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	mockClient "github.com/tidbcloud/terraform-provider-tidbcloud/mock"
+	"github.com/tidbcloud/terraform-provider-tidbcloud/tidbcloud"
+	"github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/dedicated"
+)
+
+func TestUTDedicatedClusterPauseAction(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudDedicatedClient(ctrl)
+	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudDedicatedClient, error) {
+		return s, nil
+	})()
+
+	clusterId := "cluster-id"
+
+	pauseClusterResp := dedicated.TidbCloudOpenApidedicatedv1beta1Cluster{}
+	pauseClusterResp.UnmarshalJSON([]byte(testUTTidbCloudOpenApidedicatedv1beta1Cluster(clusterId, "test-tf", string(dedicated.COMMONV1BETA1CLUSTERSTATE_PAUSING), "8C16G", "8 vCPU, 16 GiB")))
+	getClusterResp := dedicated.TidbCloudOpenApidedicatedv1beta1Cluster{}
+	getClusterResp.UnmarshalJSON([]byte(testUTTidbCloudOpenApidedicatedv1beta1Cluster(clusterId, "test-tf", string(dedicated.COMMONV1BETA1CLUSTERSTATE_PAUSED), "8C16G", "8 vCPU, 16 GiB")))
+
+	s.EXPECT().PauseCluster(gomock.Any(), clusterId).Return(&pauseClusterResp, nil).Times(1)
+	s.EXPECT().GetCluster(gomock.Any(), clusterId).Return(&getClusterResp, nil).MinTimes(1)
+
+	testUTDedicatedClusterAction(t, testUTDedicatedClusterActionConfig("tidbcloud_dedicated_cluster_pause", clusterId, true))
+}
+
+func TestUTDedicatedClusterPauseActionWithoutWait(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudDedicatedClient(ctrl)
+	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudDedicatedClient, error) {
+		return s, nil
+	})()
+
+	clusterId := "cluster-id"
+
+	pauseClusterResp := dedicated.TidbCloudOpenApidedicatedv1beta1Cluster{}
+	pauseClusterResp.UnmarshalJSON([]byte(testUTTidbCloudOpenApidedicatedv1beta1Cluster(clusterId, "test-tf", string(dedicated.COMMONV1BETA1CLUSTERSTATE_PAUSING), "8C16G", "8 vCPU, 16 GiB")))
+
+	// GetCluster is not expected, the action returns once the pause is accepted.
+	s.EXPECT().PauseCluster(gomock.Any(), clusterId).Return(&pauseClusterResp, nil).Times(1)
+
+	testUTDedicatedClusterAction(t, testUTDedicatedClusterActionConfig("tidbcloud_dedicated_cluster_pause", clusterId, false))
+}
+
+func TestUTDedicatedClusterResumeAction(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudDedicatedClient(ctrl)
+	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudDedicatedClient, error) {
+		return s, nil
+	})()
+
+	clusterId := "cluster-id"
+
+	resumeClusterResp := dedicated.TidbCloudOpenApidedicatedv1beta1Cluster{}
+	resumeClusterResp.UnmarshalJSON([]byte(testUTTidbCloudOpenApidedicatedv1beta1Cluster(clusterId, "test-tf", string(dedicated.COMMONV1BETA1CLUSTERSTATE_RESUMING), "8C16G", "8 vCPU, 16 GiB")))
+	getClusterResp := dedicated.TidbCloudOpenApidedicatedv1beta1Cluster{}
+	getClusterResp.UnmarshalJSON([]byte(testUTTidbCloudOpenApidedicatedv1beta1Cluster(clusterId, "test-tf", string(dedicated.COMMONV1BETA1CLUSTERSTATE_ACTIVE), "8C16G", "8 vCPU, 16 GiB")))
+
+	s.EXPECT().ResumeCluster(gomock.Any(), clusterId).Return(&resumeClusterResp, nil).Times(1)
+	s.EXPECT().GetCluster(gomock.Any(), clusterId).Return(&getClusterResp, nil).MinTimes(1)
+
+	testUTDedicatedClusterAction(t, testUTDedicatedClusterActionConfig("tidbcloud_dedicated_cluster_resume", clusterId, true))
+}
+
+func testUTDedicatedClusterAction(t *testing.T, config string) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		PreCheck:   func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+		},
+	})
+}
+
+func testUTDedicatedClusterActionConfig(actionType string, clusterId string, waitForCompletion bool) string {
+	return fmt.Sprintf(`
+action "%[1]s" "test" {
+  config {
+    cluster_id          = "%[2]s"
+    wait_for_completion = %[3]t
+  }
+}
+
+resource "terraform_data" "test" {
+  input = "invoke"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.%[1]s.test]
+    }
+  }
+}
+`, actionType, clusterId, waitForCompletion)
+}
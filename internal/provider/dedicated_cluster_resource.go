@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -45,6 +47,8 @@ type dedicatedClusterResourceData struct {
 	TiDBNodeSetting    tidbNodeSetting     `tfsdk:"tidb_node_setting"`
 	TiKVNodeSetting    tikvNodeSetting     `tfsdk:"tikv_node_setting"`
 	TiFlashNodeSetting *tiflashNodeSetting `tfsdk:"tiflash_node_setting"`
+
+	IgnoreDefaultGroupChanges types.Bool `tfsdk:"ignore_default_group_changes"`
 }
 
 type pausePlan struct {
@@ -81,6 +85,7 @@ var ipAccessListItemAttrTypes = map[string]attr.Type{
 }
 
 type tiProxySetting struct {
+	Enabled             types.Bool   `tfsdk:"enabled"`
 	NodeSpecKey         types.String `tfsdk:"node_spec_key"`
 	NodeSpecVersion     types.String `tfsdk:"node_spec_version"`
 	NodeCount           types.Int32  `tfsdk:"node_count"`
@@ -111,6 +116,18 @@ type tiflashNodeSetting struct {
 	RaftStoreIOPS       types.Int32  `tfsdk:"raft_store_iops"`
 }
 
+type dedicatedClusterResourceIdentity struct {
+	ClusterId types.String `tfsdk:"cluster_id"`
+}
+
+// dedicatedClusterStateUpgrades rewrites the state of each prior schema version to the next one.
+var dedicatedClusterStateUpgrades = []rawStateUpgrade{
+	// 0 => 1: the first versioned schema, the state layout is unchanged.
+	nil,
+}
+
+var _ resource.ResourceWithUpgradeState = &dedicatedClusterResource{}
+
 type dedicatedClusterResource struct {
 	provider *tidbcloudProvider
 }
@@ -139,6 +156,7 @@ func (r *dedicatedClusterResource) Configure(_ context.Context, req resource.Con
 func (r *dedicatedClusterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "dedicated cluster resource",
+		Version:             int64(len(dedicatedClusterStateUpgrades)),
 		Attributes: map[string]schema.Attribute{
 			"project_id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the project. When not provided, the default project will be used.",
@@ -196,6 +214,11 @@ func (r *dedicatedClusterResource) Schema(_ context.Context, _ resource.SchemaRe
 				MarkdownDescription: "Whether the cluster is paused.",
 				Optional:            true,
 			},
+			"ignore_default_group_changes": schema.BoolAttribute{
+				MarkdownDescription: "Whether the default node group is managed by a tidbcloud_dedicated_node_group resource with adopt_default_group = true. " +
+					"If true, node_count, tiproxy_setting and public_endpoint_setting of tidb_node_setting are only used to create the cluster, and their later changes are ignored.",
+				Optional: true,
+			},
 			"pause_plan": schema.SingleNestedAttribute{
 				MarkdownDescription: "Pause plan details for the cluster.",
 				Computed:            true,
@@ -298,8 +321,12 @@ func (r *dedicatedClusterResource) Schema(_ context.Context, _ resource.SchemaRe
 						MarkdownDescription: "Settings for TiProxy nodes.",
 						Optional:            true,
 						Attributes: map[string]schema.Attribute{
+							"enabled": schema.BoolAttribute{
+								MarkdownDescription: "Whether TiProxy is enabled. Set it to false to disable TiProxy and keep the settings. Removing the block also disables TiProxy. Default is true.",
+								Optional:            true,
+							},
 							"node_spec_key": schema.StringAttribute{
-								MarkdownDescription: "The key of the node spec.",
+								MarkdownDescription: "The key of the node spec, e.g. 2C4G.",
 								Required:            true,
 							},
 							"node_spec_version": schema.StringAttribute{
@@ -309,6 +336,7 @@ func (r *dedicatedClusterResource) Schema(_ context.Context, _ resource.SchemaRe
 							"node_count": schema.Int32Attribute{
 								MarkdownDescription: "The number of TiProxy nodes.",
 								Required:            true,
+								Validators:          []validator.Int32{atLeastInt32Validator{min: 1}},
 							},
 							"node_spec_display_name": schema.StringAttribute{
 								MarkdownDescription: "The display name of the node spec.",
@@ -425,6 +453,21 @@ func (r *dedicatedClusterResource) Schema(_ context.Context, _ resource.SchemaRe
 	}
 }
 
+func (r *dedicatedClusterResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(dedicatedClusterStateUpgrades)
+}
+
+func (r *dedicatedClusterResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"cluster_id": identityschema.StringAttribute{
+				Description:       "The ID of the cluster.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r dedicatedClusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if !r.provider.configured {
 		resp.Diagnostics.AddError(
@@ -488,6 +531,7 @@ func (r dedicatedClusterResource) Create(ctx context.Context, req resource.Creat
 	// save into the Terraform state.
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, dedicatedClusterResourceIdentity{ClusterId: data.ClusterId})...)
 }
 
 func (r dedicatedClusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -510,23 +554,46 @@ func (r dedicatedClusterResource) Read(ctx context.Context, req resource.ReadReq
 	var data dedicatedClusterResourceData
 	var rootPassword types.String
 	var paused types.Bool
+	var ignoreDefaultGroupChanges types.Bool
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("root_password"), &rootPassword)...)
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("paused"), &paused)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ignore_default_group_changes"), &ignoreDefaultGroupChanges)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("tidb_node_setting").AtName("tiproxy_setting"), &data.TiDBNodeSetting.TiProxySetting)...)
 	data.RootPassword = rootPassword
 	data.Paused = paused
+	data.IgnoreDefaultGroupChanges = ignoreDefaultGroupChanges
 
-	refreshDedicatedClusterResourceData(ctx, cluster, &data)
+	if ignoreDefaultGroupChanges.ValueBool() {
+		var prior tidbNodeSetting
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("tidb_node_setting"), &prior)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		refreshDedicatedClusterResourceData(ctx, cluster, &data)
+		keepDefaultGroupSettings(prior, &data.TiDBNodeSetting)
+	} else {
+		refreshDedicatedClusterResourceData(ctx, cluster, &data)
 
-	publicEndpointSetting, err := r.provider.DedicatedClient.GetPublicEndpoint(ctx, clusterId, data.TiDBNodeSetting.NodeGroupId.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to call GetPublicEndpoint, got error: %s", err))
-		return
+		publicEndpointSetting, err := r.provider.DedicatedClient.GetPublicEndpoint(ctx, clusterId, data.TiDBNodeSetting.NodeGroupId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to call GetPublicEndpoint, got error: %s", err))
+			return
+		}
+		data.TiDBNodeSetting.PublicEndpointSetting = convertDedicatedPublicEndpointSetting(publicEndpointSetting)
 	}
-	data.TiDBNodeSetting.PublicEndpointSetting = convertDedicatedPublicEndpointSetting(publicEndpointSetting)
 
 	// save into the Terraform state
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, dedicatedClusterResourceIdentity{ClusterId: data.ClusterId})...)
+}
+
+// keepDefaultGroupSettings keeps the settings of the default node group which are ignored by the cluster resource
+// when ignore_default_group_changes is true.
+func keepDefaultGroupSettings(prior tidbNodeSetting, data *tidbNodeSetting) {
+	data.NodeCount = prior.NodeCount
+	data.TiProxySetting = prior.TiProxySetting
+	data.PublicEndpointSetting = prior.PublicEndpointSetting
 }
 
 func refreshDedicatedClusterResourceData(ctx context.Context, resp *dedicated.TidbCloudOpenApidedicatedv1beta1Cluster, data *dedicatedClusterResourceData) diag.Diagnostics {
@@ -573,14 +640,7 @@ func refreshDedicatedClusterResourceData(ctx context.Context, resp *dedicated.Ti
 				AttrTypes: endpointItemAttrTypes,
 			}, endpoints)
 			diags.Append(listDiags...)
-			if group.TiproxySetting != nil {
-				data.TiDBNodeSetting.TiProxySetting = &tiProxySetting{
-					NodeSpecKey:         types.StringValue(group.TiproxySetting.NodeSpecKey),
-					NodeSpecVersion:     types.StringValue(*group.TiproxySetting.NodeSpecVersion),
-					NodeCount:           types.Int32Value(*group.TiproxySetting.NodeCount.Get()),
-					NodeSpecDisplayName: types.StringValue(*group.TiproxySetting.NodeSpecDisplayName),
-				}
-			}
+			data.TiDBNodeSetting.TiProxySetting = refreshTiProxySetting(group.TiproxySetting, data.TiDBNodeSetting.TiProxySetting)
 
 			data.TiDBNodeSetting.NodeSpecKey = types.StringValue(*group.NodeSpecKey)
 			data.TiDBNodeSetting.NodeCount = types.Int32Value(group.NodeCount)
@@ -679,12 +739,12 @@ func (r dedicatedClusterResource) Update(ctx context.Context, req resource.Updat
 		isPublicEndpointSettingChanging = true
 	}
 
-	// Check if any other attributes are changing
-	isOtherAttributesChanging := plan.DisplayName != state.DisplayName ||
+	isTiProxySettingChanging := tiProxySettingChanged(plan.TiDBNodeSetting.TiProxySetting, state.TiDBNodeSetting.TiProxySetting)
+
+	// Check if the attributes updated by UpdateCluster are changing
+	isClusterSettingChanging := plan.DisplayName != state.DisplayName ||
 		plan.TiDBNodeSetting.NodeCount != state.TiDBNodeSetting.NodeCount ||
 		plan.TiDBNodeSetting.NodeSpecKey != state.TiDBNodeSetting.NodeSpecKey ||
-		plan.TiDBNodeSetting.TiProxySetting != state.TiDBNodeSetting.TiProxySetting ||
-		isPublicEndpointSettingChanging ||
 
 		plan.TiKVNodeSetting.NodeCount != state.TiKVNodeSetting.NodeCount ||
 		plan.TiKVNodeSetting.NodeSpecKey != state.TiKVNodeSetting.NodeSpecKey ||
@@ -692,9 +752,14 @@ func (r dedicatedClusterResource) Update(ctx context.Context, req resource.Updat
 		plan.TiKVNodeSetting.StorageType != state.TiKVNodeSetting.StorageType ||
 		plan.TiKVNodeSetting.RaftStoreIOPS != state.TiKVNodeSetting.RaftStoreIOPS ||
 
-		plan.RootPassword != state.RootPassword ||
 		isTiFlashNodeSettingChanging
 
+	// Check if any other attributes are changing
+	isOtherAttributesChanging := isClusterSettingChanging ||
+		isTiProxySettingChanging ||
+		isPublicEndpointSettingChanging ||
+		plan.RootPassword != state.RootPassword
+
 	// If trying to change pause state along with other attributes, return an error
 	if isPauseStateChanging && isOtherAttributesChanging {
 		resp.Diagnostics.AddError(
@@ -732,7 +797,8 @@ func (r dedicatedClusterResource) Update(ctx context.Context, req resource.Updat
 			}
 		}
 
-		if isPublicEndpointSettingChanging {
+		ignoreDefaultGroupChanges := plan.IgnoreDefaultGroupChanges.ValueBool()
+		if isPublicEndpointSettingChanging && !ignoreDefaultGroupChanges {
 			// using tidb node group api update public endpoint setting
 			pes, err := updatePublicEndpointSetting(ctx, r.provider.DedicatedClient, state.ClusterId.ValueString(), state.TiDBNodeSetting.NodeGroupId.ValueString(), plan.TiDBNodeSetting.PublicEndpointSetting)
 			if err != nil {
@@ -744,69 +810,75 @@ func (r dedicatedClusterResource) Update(ctx context.Context, req resource.Updat
 			time.Sleep(1 * time.Minute)
 		}
 
-		body := &dedicated.TheUpdatedClusterConfiguration{}
-		// components change
-		// tidb
-		defaultNodeGroup := dedicated.UpdateClusterRequestTidbNodeSettingTidbNodeGroup{}
-		nodeCount := plan.TiDBNodeSetting.NodeCount.ValueInt32()
-		defaultNodeGroup.NodeCount = *dedicated.NewNullableInt32(&nodeCount)
-		if plan.TiDBNodeSetting.TiProxySetting != nil {
-			tiProxySetting := dedicated.Dedicatedv1beta1TidbNodeGroupTiProxySetting{}
-			tiProxyNodeCount := plan.TiDBNodeSetting.TiProxySetting.NodeCount.ValueInt32()
-			tiProxyNodeSpecKey := plan.TiDBNodeSetting.TiProxySetting.NodeSpecKey.ValueString()
-			tiProxySetting.NodeCount = *dedicated.NewNullableInt32(&tiProxyNodeCount)
-			tiProxySetting.NodeSpecKey = tiProxyNodeSpecKey
-			defaultNodeGroup.TiproxySetting = &tiProxySetting
-		}
-		body.TidbNodeSetting = &dedicated.V1beta1UpdateClusterRequestTidbNodeSetting{
-			NodeSpecKey: plan.TiDBNodeSetting.NodeSpecKey.ValueStringPointer(),
-			TidbNodeGroups: []dedicated.UpdateClusterRequestTidbNodeSettingTidbNodeGroup{
-				defaultNodeGroup,
-			},
+		if isTiProxySettingChanging && !ignoreDefaultGroupChanges {
+			// update TiProxy through the node group api, so the TiDB nodes are not touched
+			err := updateTiProxySetting(ctx, r.provider.DedicatedClient, state.ClusterId.ValueString(), state.TiDBNodeSetting.NodeGroupId.ValueString(),
+				plan.TiDBNodeSetting.TiProxySetting, state.TiDBNodeSetting.TiProxySetting)
+			if err != nil {
+				resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update TiProxy setting, got error: %s", err))
+				return
+			}
 		}
 
-		// tikv
-		nodeCountInt32 := int32(plan.TiKVNodeSetting.NodeCount.ValueInt32())
-		storageSizeGiInt32 := int32(plan.TiKVNodeSetting.StorageSizeGi.ValueInt32())
-		storageType := dedicated.ClusterStorageNodeSettingStorageType(plan.TiKVNodeSetting.StorageType.ValueString())
-		body.TikvNodeSetting = &dedicated.V1beta1UpdateClusterRequestStorageNodeSetting{
-			NodeSpecKey:   plan.TiKVNodeSetting.NodeSpecKey.ValueStringPointer(),
-			NodeCount:     *dedicated.NewNullableInt32(&nodeCountInt32),
-			StorageSizeGi: &storageSizeGiInt32,
-			StorageType:   &storageType,
-		}
-		if IsKnown(plan.TiKVNodeSetting.RaftStoreIOPS) {
-			raftStoreIOPS := plan.TiKVNodeSetting.RaftStoreIOPS.ValueInt32()
-			body.TikvNodeSetting.RaftStoreIops = *dedicated.NewNullableInt32(&raftStoreIOPS)
-		}
+		if isClusterSettingChanging {
+			body := &dedicated.TheUpdatedClusterConfiguration{}
+			// components change
+			// tidb
+			defaultNodeGroup := dedicated.UpdateClusterRequestTidbNodeSettingTidbNodeGroup{}
+			nodeCount := plan.TiDBNodeSetting.NodeCount.ValueInt32()
+			defaultNodeGroup.NodeCount = *dedicated.NewNullableInt32(&nodeCount)
+			body.TidbNodeSetting = &dedicated.V1beta1UpdateClusterRequestTidbNodeSetting{
+				NodeSpecKey: plan.TiDBNodeSetting.NodeSpecKey.ValueStringPointer(),
+			}
+			if !ignoreDefaultGroupChanges {
+				body.TidbNodeSetting.TidbNodeGroups = []dedicated.UpdateClusterRequestTidbNodeSettingTidbNodeGroup{
+					defaultNodeGroup,
+				}
+			}
 
-		// tiflash
-		if plan.TiFlashNodeSetting != nil {
-			nodeCountInt32 := int32(plan.TiFlashNodeSetting.NodeCount.ValueInt32())
-			storageSizeGiInt32 := int32(plan.TiFlashNodeSetting.StorageSizeGi.ValueInt32())
-			storageType := dedicated.ClusterStorageNodeSettingStorageType(plan.TiFlashNodeSetting.StorageType.ValueString())
-			body.TiflashNodeSetting = &dedicated.V1beta1UpdateClusterRequestStorageNodeSetting{
-				NodeSpecKey:   plan.TiFlashNodeSetting.NodeSpecKey.ValueStringPointer(),
+			// tikv
+			nodeCountInt32 := int32(plan.TiKVNodeSetting.NodeCount.ValueInt32())
+			storageSizeGiInt32 := int32(plan.TiKVNodeSetting.StorageSizeGi.ValueInt32())
+			storageType := dedicated.ClusterStorageNodeSettingStorageType(plan.TiKVNodeSetting.StorageType.ValueString())
+			body.TikvNodeSetting = &dedicated.V1beta1UpdateClusterRequestStorageNodeSetting{
+				NodeSpecKey:   plan.TiKVNodeSetting.NodeSpecKey.ValueStringPointer(),
 				NodeCount:     *dedicated.NewNullableInt32(&nodeCountInt32),
 				StorageSizeGi: &storageSizeGiInt32,
 				StorageType:   &storageType,
 			}
-			if IsKnown(plan.TiFlashNodeSetting.RaftStoreIOPS) {
-				raftStoreIOPS := plan.TiFlashNodeSetting.RaftStoreIOPS.ValueInt32()
-				body.TiflashNodeSetting.RaftStoreIops = *dedicated.NewNullableInt32(&raftStoreIOPS)
+			if IsKnown(plan.TiKVNodeSetting.RaftStoreIOPS) {
+				raftStoreIOPS := plan.TiKVNodeSetting.RaftStoreIOPS.ValueInt32()
+				body.TikvNodeSetting.RaftStoreIops = *dedicated.NewNullableInt32(&raftStoreIOPS)
 			}
-		}
 
-		if plan.DisplayName != state.DisplayName {
-			body.DisplayName = plan.DisplayName.ValueStringPointer()
-		}
+			// tiflash
+			if plan.TiFlashNodeSetting != nil {
+				nodeCountInt32 := int32(plan.TiFlashNodeSetting.NodeCount.ValueInt32())
+				storageSizeGiInt32 := int32(plan.TiFlashNodeSetting.StorageSizeGi.ValueInt32())
+				storageType := dedicated.ClusterStorageNodeSettingStorageType(plan.TiFlashNodeSetting.StorageType.ValueString())
+				body.TiflashNodeSetting = &dedicated.V1beta1UpdateClusterRequestStorageNodeSetting{
+					NodeSpecKey:   plan.TiFlashNodeSetting.NodeSpecKey.ValueStringPointer(),
+					NodeCount:     *dedicated.NewNullableInt32(&nodeCountInt32),
+					StorageSizeGi: &storageSizeGiInt32,
+					StorageType:   &storageType,
+				}
+				if IsKnown(plan.TiFlashNodeSetting.RaftStoreIOPS) {
+					raftStoreIOPS := plan.TiFlashNodeSetting.RaftStoreIOPS.ValueInt32()
+					body.TiflashNodeSetting.RaftStoreIops = *dedicated.NewNullableInt32(&raftStoreIOPS)
+				}
+			}
 
-		// call update api
-		tflog.Trace(ctx, "update dedicated_cluster_resource")
-		_, err := r.provider.DedicatedClient.UpdateCluster(ctx, state.ClusterId.ValueString(), body)
-		if err != nil {
-			resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to call UpdateCluster, got error: %s", err))
-			return
+			if plan.DisplayName != state.DisplayName {
+				body.DisplayName = plan.DisplayName.ValueStringPointer()
+			}
+
+			// call update api
+			tflog.Trace(ctx, "update dedicated_cluster_resource")
+			_, err := r.provider.DedicatedClient.UpdateCluster(ctx, state.ClusterId.ValueString(), body)
+			if err != nil {
+				resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to call UpdateCluster, got error: %s", err))
+				return
+			}
 		}
 	}
 	tflog.Info(ctx, "wait cluster ready")
@@ -819,9 +891,14 @@ func (r dedicatedClusterResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	state.TiDBNodeSetting.TiProxySetting = plan.TiDBNodeSetting.TiProxySetting
 	refreshDedicatedClusterResourceData(ctx, cluster, &state)
 	state.Paused = plan.Paused
 	state.RootPassword = plan.RootPassword
+	state.IgnoreDefaultGroupChanges = plan.IgnoreDefaultGroupChanges
+	if plan.IgnoreDefaultGroupChanges.ValueBool() {
+		keepDefaultGroupSettings(plan.TiDBNodeSetting, &state.TiDBNodeSetting)
+	}
 
 	// save into the Terraform state.
 	diags = resp.State.Set(ctx, &state)
@@ -849,6 +926,102 @@ func convertDedicatedPublicEndpointSetting(resp *dedicated.V1beta1PublicEndpoint
 	return &publicEndpointSetting
 }
 
+// tiProxyEnabled reports whether the TiProxy setting enables TiProxy. It is enabled unless the block is removed
+// or enabled is false.
+func tiProxyEnabled(setting *tiProxySetting) bool {
+	return setting != nil && (setting.Enabled.IsNull() || setting.Enabled.ValueBool())
+}
+
+// tiProxySettingChanged compares the TiProxy settings by value. The computed attributes are ignored.
+func tiProxySettingChanged(plan *tiProxySetting, state *tiProxySetting) bool {
+	if tiProxyEnabled(plan) != tiProxyEnabled(state) {
+		return true
+	}
+	if !tiProxyEnabled(plan) {
+		return false
+	}
+	return !plan.NodeSpecKey.Equal(state.NodeSpecKey) || !plan.NodeCount.Equal(state.NodeCount)
+}
+
+// convertTiProxySetting converts the TiProxy setting of a node group. TiProxy is disabled when its node count is 0.
+func convertTiProxySetting(resp *dedicated.Dedicatedv1beta1TidbNodeGroupTiProxySetting) *tiProxySetting {
+	if resp == nil {
+		return nil
+	}
+	nodeCount := int32(0)
+	if resp.NodeCount.Get() != nil {
+		nodeCount = *resp.NodeCount.Get()
+	}
+	return &tiProxySetting{
+		Enabled:             types.BoolValue(nodeCount > 0),
+		NodeSpecKey:         types.StringValue(resp.NodeSpecKey),
+		NodeSpecVersion:     types.StringPointerValue(resp.NodeSpecVersion),
+		NodeCount:           types.Int32Value(nodeCount),
+		NodeSpecDisplayName: types.StringPointerValue(resp.NodeSpecDisplayName),
+	}
+}
+
+// refreshTiProxySetting returns the TiProxy setting saved in the state of a resource, based on the prior setting
+// from the state or plan. A disabled TiProxy is saved as it was configured, i.e. without the block or with enabled = false.
+func refreshTiProxySetting(resp *dedicated.Dedicatedv1beta1TidbNodeGroupTiProxySetting, prior *tiProxySetting) *tiProxySetting {
+	setting := convertTiProxySetting(resp)
+	if setting == nil || !setting.Enabled.ValueBool() {
+		if prior == nil {
+			return nil
+		}
+		disabled := &tiProxySetting{
+			Enabled:             types.BoolValue(false),
+			NodeSpecKey:         prior.NodeSpecKey,
+			NodeSpecVersion:     types.StringNull(),
+			NodeCount:           prior.NodeCount,
+			NodeSpecDisplayName: types.StringNull(),
+		}
+		if setting != nil {
+			disabled.NodeSpecVersion = setting.NodeSpecVersion
+			disabled.NodeSpecDisplayName = setting.NodeSpecDisplayName
+		}
+		return disabled
+	}
+	if prior == nil || prior.Enabled.IsNull() {
+		// enabled by default
+		setting.Enabled = types.BoolNull()
+	}
+	return setting
+}
+
+// buildTiProxySetting builds the TiProxy setting of a node group update from the planned and current settings.
+// TiProxy is disabled by setting its node count to 0.
+func buildTiProxySetting(plan *tiProxySetting, state *tiProxySetting) *dedicated.Dedicatedv1beta1TidbNodeGroupTiProxySetting {
+	setting := dedicated.Dedicatedv1beta1TidbNodeGroupTiProxySetting{}
+	nodeCount := int32(0)
+	if plan != nil {
+		setting.NodeSpecKey = plan.NodeSpecKey.ValueString()
+	} else if state != nil {
+		setting.NodeSpecKey = state.NodeSpecKey.ValueString()
+	}
+	if tiProxyEnabled(plan) {
+		nodeCount = plan.NodeCount.ValueInt32()
+	}
+	setting.NodeCount = *dedicated.NewNullableInt32(&nodeCount)
+	return &setting
+}
+
+// updateTiProxySetting updates only the TiProxy setting of a node group, and waits for the node group to be ready.
+func updateTiProxySetting(ctx context.Context, client tidbcloud.TiDBCloudDedicatedClient, clusterId string, nodeGroupId string, plan *tiProxySetting, state *tiProxySetting) error {
+	body := dedicated.TidbNodeGroupServiceUpdateTidbNodeGroupRequest{
+		TiproxySetting: buildTiProxySetting(plan, state),
+	}
+	tflog.Trace(ctx, "update tiproxy setting")
+	if _, err := client.UpdateTiDBNodeGroup(ctx, clusterId, nodeGroupId, &body); err != nil {
+		return fmt.Errorf("unable to call UpdateTiDBNodeGroup, got error: %s", err)
+	}
+	tflog.Info(ctx, "wait node group ready after updating tiproxy setting")
+	if _, err := WaitDedicatedNodeGroupReady(ctx, clusterUpdateTimeout, clusterUpdateInterval, clusterId, nodeGroupId, client); err != nil {
+		return fmt.Errorf("node group is not ready, got error: %s", err)
+	}
+	return nil
+}
+
 func (r dedicatedClusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var clusterId string
 
@@ -866,7 +1039,30 @@ func (r dedicatedClusterResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r dedicatedClusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("cluster_id"), req, resp)
+	projectId, displayName, byName, err := parseImportByName(req.ID, "project_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+	if !byName {
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("cluster_id"), path.Root("cluster_id"), req, resp)
+		return
+	}
+
+	clusters, err := (&dedicatedClustersDataSource{provider: r.provider}).retrieveClusters(ctx, projectId)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to call ListClusters, got error: %s", err))
+		return
+	}
+	clusterId, err := resolveDisplayName(clusters, displayName, "dedicated cluster", "project "+projectId,
+		func(c dedicated.TidbCloudOpenApidedicatedv1beta1Cluster) (string, string) {
+			return c.DisplayName, *c.ClusterId
+		})
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), clusterId)...)
 }
 
 func WaitDedicatedClusterReady(ctx context.Context, timeout time.Duration, interval time.Duration, clusterId string,
@@ -924,7 +1120,7 @@ func buildCreateDedicatedClusterBody(data dedicatedClusterResourceData) (dedicat
 	// tidb node groups
 	defaultNodeGroup := dedicated.Dedicatedv1beta1TidbNodeGroup{}
 	defaultNodeGroup.NodeCount = data.TiDBNodeSetting.NodeCount.ValueInt32()
-	if data.TiDBNodeSetting.TiProxySetting != nil {
+	if tiProxyEnabled(data.TiDBNodeSetting.TiProxySetting) {
 		tiProxySetting := dedicated.Dedicatedv1beta1TidbNodeGroupTiProxySetting{}
 		tiProxyNodeCount := data.TiDBNodeSetting.TiProxySetting.NodeCount.ValueInt32()
 		tiProxyNodeSpecKey := data.TiDBNodeSetting.TiProxySetting.NodeSpecKey.ValueString()
@@ -2,10 +2,12 @@ package provider
 
 import (
 	"fmt"
+	"net/http"
 	"regexp"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	mockClient "github.com/tidbcloud/terraform-provider-tidbcloud/mock"
 	"github.com/tidbcloud/terraform-provider-tidbcloud/tidbcloud"
@@ -58,7 +60,7 @@ func TestUTDedicatedClusterResource(t *testing.T) {
 
 	ctrl := gomock.NewController(t)
 	s := mockClient.NewMockTiDBCloudDedicatedClient(ctrl)
-	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string) (tidbcloud.TiDBCloudDedicatedClient, error) {
+	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudDedicatedClient, error) {
 		return s, nil
 	})()
 
@@ -95,6 +97,104 @@ func TestUTDedicatedClusterResource(t *testing.T) {
 	testDedicatedClusterResource(t)
 }
 
+func TestUTTiProxySettingChanged(t *testing.T) {
+	setting := func(enabled types.Bool, nodeSpecKey string, nodeCount int32) *tiProxySetting {
+		return &tiProxySetting{
+			Enabled:             enabled,
+			NodeSpecKey:         types.StringValue(nodeSpecKey),
+			NodeSpecVersion:     types.StringUnknown(),
+			NodeCount:           types.Int32Value(nodeCount),
+			NodeSpecDisplayName: types.StringUnknown(),
+		}
+	}
+	state := &tiProxySetting{
+		Enabled:             types.BoolNull(),
+		NodeSpecKey:         types.StringValue("2C4G"),
+		NodeSpecVersion:     types.StringValue("v1"),
+		NodeCount:           types.Int32Value(2),
+		NodeSpecDisplayName: types.StringValue("2 vCPU, 4 GiB"),
+	}
+	tests := []struct {
+		name    string
+		plan    *tiProxySetting
+		state   *tiProxySetting
+		changed bool
+	}{
+		{name: "same values", plan: setting(types.BoolNull(), "2C4G", 2), state: state},
+		{name: "enabled explicitly", plan: setting(types.BoolValue(true), "2C4G", 2), state: state},
+		{name: "node count", plan: setting(types.BoolNull(), "2C4G", 3), state: state, changed: true},
+		{name: "node spec", plan: setting(types.BoolNull(), "4C8G", 2), state: state, changed: true},
+		{name: "disabled", plan: setting(types.BoolValue(false), "2C4G", 2), state: state, changed: true},
+		{name: "removed", plan: nil, state: state, changed: true},
+		{name: "added", plan: setting(types.BoolNull(), "2C4G", 2), state: nil, changed: true},
+		{name: "disabled and removed", plan: nil, state: setting(types.BoolValue(false), "2C4G", 2)},
+		{name: "disabled with other values", plan: setting(types.BoolValue(false), "4C8G", 3), state: setting(types.BoolValue(false), "2C4G", 2)},
+	}
+	for _, tt := range tests {
+		if changed := tiProxySettingChanged(tt.plan, tt.state); changed != tt.changed {
+			t.Errorf("%s: tiProxySettingChanged() = %v, expected %v", tt.name, changed, tt.changed)
+		}
+	}
+}
+
+func TestUTRefreshTiProxySetting(t *testing.T) {
+	enabled := func(nodeCount int32) *dedicated.Dedicatedv1beta1TidbNodeGroupTiProxySetting {
+		version, displayName := "v1", "2 vCPU, 4 GiB"
+		return &dedicated.Dedicatedv1beta1TidbNodeGroupTiProxySetting{
+			NodeSpecKey:         "2C4G",
+			NodeSpecVersion:     &version,
+			NodeCount:           *dedicated.NewNullableInt32(&nodeCount),
+			NodeSpecDisplayName: &displayName,
+		}
+	}
+	prior := &tiProxySetting{
+		Enabled:     types.BoolNull(),
+		NodeSpecKey: types.StringValue("2C4G"),
+		NodeCount:   types.Int32Value(2),
+	}
+
+	setting := refreshTiProxySetting(enabled(2), prior)
+	if setting == nil || !setting.Enabled.IsNull() || setting.NodeCount.ValueInt32() != 2 || setting.NodeSpecVersion.ValueString() != "v1" {
+		t.Errorf("expected an enabled setting with enabled unset, got %+v", setting)
+	}
+	setting = refreshTiProxySetting(enabled(2), &tiProxySetting{Enabled: types.BoolValue(true)})
+	if setting == nil || !setting.Enabled.ValueBool() {
+		t.Errorf("expected enabled = true to be kept, got %+v", setting)
+	}
+	if setting = refreshTiProxySetting(enabled(0), nil); setting != nil {
+		t.Errorf("expected no setting when TiProxy is disabled and not configured, got %+v", setting)
+	}
+	setting = refreshTiProxySetting(enabled(0), &tiProxySetting{
+		Enabled:     types.BoolValue(false),
+		NodeSpecKey: types.StringValue("4C8G"),
+		NodeCount:   types.Int32Value(3),
+	})
+	if setting == nil || setting.Enabled.ValueBool() || setting.NodeSpecKey.ValueString() != "4C8G" || setting.NodeCount.ValueInt32() != 3 {
+		t.Errorf("expected the configured values of a disabled TiProxy to be kept, got %+v", setting)
+	}
+}
+
+func TestUTBuildTiProxySetting(t *testing.T) {
+	plan := &tiProxySetting{
+		Enabled:     types.BoolNull(),
+		NodeSpecKey: types.StringValue("4C8G"),
+		NodeCount:   types.Int32Value(3),
+	}
+	setting := buildTiProxySetting(plan, nil)
+	if setting.NodeSpecKey != "4C8G" || *setting.NodeCount.Get() != 3 {
+		t.Errorf("expected 4C8G with 3 nodes, got %s with %d nodes", setting.NodeSpecKey, *setting.NodeCount.Get())
+	}
+	plan.Enabled = types.BoolValue(false)
+	setting = buildTiProxySetting(plan, nil)
+	if setting.NodeSpecKey != "4C8G" || *setting.NodeCount.Get() != 0 {
+		t.Errorf("expected 4C8G with 0 nodes, got %s with %d nodes", setting.NodeSpecKey, *setting.NodeCount.Get())
+	}
+	setting = buildTiProxySetting(nil, &tiProxySetting{NodeSpecKey: types.StringValue("2C4G"), NodeCount: types.Int32Value(2)})
+	if setting.NodeSpecKey != "2C4G" || *setting.NodeCount.Get() != 0 {
+		t.Errorf("expected 2C4G with 0 nodes, got %s with %d nodes", setting.NodeSpecKey, *setting.NodeCount.Get())
+	}
+}
+
 func testDedicatedClusterResource(t *testing.T) {
 	dedicatedClusterResourceName := "tidbcloud_dedicated_cluster.test"
 	resource.Test(t, resource.TestCase{
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/dedicated"
+)
+
+type dedicatedClusterRotateRootPasswordActionData struct {
+	ClusterId    types.String `tfsdk:"cluster_id"`
+	RootPassword types.String `tfsdk:"root_password"`
+}
+
+type dedicatedClusterRotateRootPasswordAction struct {
+	provider *tidbcloudProvider
+}
+
+var _ action.ActionWithConfigure = &dedicatedClusterRotateRootPasswordAction{}
+
+func NewDedicatedClusterRotateRootPasswordAction() action.Action {
+	return &dedicatedClusterRotateRootPasswordAction{}
+}
+
+func (a *dedicatedClusterRotateRootPasswordAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dedicated_cluster_rotate_root_password"
+}
+
+func (a *dedicatedClusterRotateRootPasswordAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	var ok bool
+	if a.provider, ok = req.ProviderData.(*tidbcloudProvider); !ok {
+		resp.Diagnostics.AddError("Internal provider error",
+			fmt.Sprintf("Error in Configure: expected %T but got %T", tidbcloudProvider{}, req.ProviderData))
+	}
+}
+
+func (a *dedicatedClusterRotateRootPasswordAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Changes the root password of a dedicated cluster. The root_password attribute of tidbcloud_dedicated_cluster is not changed.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the cluster.",
+				Required:            true,
+			},
+			"root_password": schema.StringAttribute{
+				MarkdownDescription: "The new root password of the cluster. It is never saved, so it can be an ephemeral value.",
+				Required:            true,
+				WriteOnly:           true,
+			},
+		},
+	}
+}
+
+func (a *dedicatedClusterRotateRootPasswordAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if !a.provider.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	var data dedicatedClusterRotateRootPasswordActionData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "invoke dedicated_cluster_rotate_root_password_action")
+	err := a.provider.DedicatedClient.ChangeClusterRootPassword(ctx, data.ClusterId.ValueString(), &dedicated.V1beta1ClusterServiceResetRootPasswordBody{
+		RootPassword: data.RootPassword.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Rotate Error", fmt.Sprintf("Unable to call ChangeClusterRootPassword, got error: %s", err))
+	}
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	mockClient "github.com/tidbcloud/terraform-provider-tidbcloud/mock"
+	"github.com/tidbcloud/terraform-provider-tidbcloud/tidbcloud"
+	"github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/dedicated"
+)
+
+func TestUTDedicatedClusterRotateRootPasswordAction(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudDedicatedClient(ctrl)
+	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudDedicatedClient, error) {
+		return s, nil
+	})()
+
+	s.EXPECT().ChangeClusterRootPassword(gomock.Any(), "cluster-id", &dedicated.V1beta1ClusterServiceResetRootPasswordBody{
+		RootPassword: "new-password",
+	}).Return(nil).Times(1)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		PreCheck:   func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUTDedicatedClusterRotateRootPasswordActionConfig,
+			},
+		},
+	})
+}
+
+const testUTDedicatedClusterRotateRootPasswordActionConfig = `
+action "tidbcloud_dedicated_cluster_rotate_root_password" "test" {
+  config {
+    cluster_id    = "cluster-id"
+    root_password = "new-password"
+  }
+}
+
+resource "terraform_data" "test" {
+  input = "rotate"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.tidbcloud_dedicated_cluster_rotate_root_password.test]
+    }
+  }
+}
+`
@@ -169,6 +169,10 @@ func (d *dedicatedClustersDataSource) Schema(_ context.Context, _ datasource.Sch
 									MarkdownDescription: "Settings for TiProxy nodes.",
 									Computed:            true,
 									Attributes: map[string]schema.Attribute{
+										"enabled": schema.BoolAttribute{
+											MarkdownDescription: "Whether TiProxy is enabled.",
+											Computed:            true,
+										},
 										"node_spec_key": schema.StringAttribute{
 											MarkdownDescription: "The key of the node spec.",
 											Computed:            true,
@@ -333,13 +337,8 @@ func (d *dedicatedClustersDataSource) Read(ctx context.Context, req datasource.R
 				}, endpoints)
 				diags.Append(listDiags...)
 				defaultTiProxySetting := tiProxySetting{}
-				if group.TiproxySetting != nil {
-					defaultTiProxySetting = tiProxySetting{
-						NodeSpecKey:         types.StringValue(group.TiproxySetting.NodeSpecKey),
-						NodeSpecVersion:     types.StringValue(*group.TiproxySetting.NodeSpecVersion),
-						NodeCount:           types.Int32Value(*group.TiproxySetting.NodeCount.Get()),
-						NodeSpecDisplayName: types.StringValue(*group.TiproxySetting.NodeSpecDisplayName),
-					}
+				if s := convertTiProxySetting(group.TiproxySetting); s != nil {
+					defaultTiProxySetting = *s
 				}
 
 				publicEndpointSetting, err := d.provider.DedicatedClient.GetPublicEndpoint(ctx, c.ClusterId.ValueString(), *group.TidbNodeGroupId)
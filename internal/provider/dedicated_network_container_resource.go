@@ -2,18 +2,29 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/dedicated"
 )
 
+const (
+	dedicatedNetworkContainerDeleteWaitInterval = 30 * time.Second
+	dedicatedNetworkContainerMinCidrPrefix      = 16
+	dedicatedNetworkContainerMaxCidrPrefix      = 23
+)
+
 var (
 	_ resource.Resource = &DedicatedNetworkContainerResource{}
 )
@@ -32,6 +43,7 @@ type DedicatedNetworkContainerResourceData struct {
 	RegionDisplayName  types.String `tfsdk:"region_display_name"`
 	VpcId              types.String `tfsdk:"vpc_id"`
 	Labels             types.Map    `tfsdk:"labels"`
+	DeleteWaitTimeout  types.String `tfsdk:"delete_wait_timeout"`
 }
 
 func NewDedicatedNetworkContainerResource() resource.Resource {
@@ -65,10 +77,19 @@ func (r *DedicatedNetworkContainerResource) Schema(_ context.Context, _ resource
 			"region_id": schema.StringAttribute{
 				Description: "The region ID for the network container",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"cidr_notation": schema.StringAttribute{
-				Description: "CIDR notation for the network container",
+				Description: "CIDR notation for the network container. It must be in an RFC 1918 private range, with a prefix length between /16 and /23",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					cidrValidator{minPrefix: dedicatedNetworkContainerMinCidrPrefix, maxPrefix: dedicatedNetworkContainerMaxCidrPrefix, private: true},
+				},
 			},
 			"state": schema.StringAttribute{
 				MarkdownDescription: "The state of the network container",
@@ -97,6 +118,14 @@ func (r *DedicatedNetworkContainerResource) Schema(_ context.Context, _ resource
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"delete_wait_timeout": schema.StringAttribute{
+				Description: "How long to wait on destroy for the dedicated clusters and VPC peerings which use the network container to be deleted, e.g. 30m. " +
+					"By default, destroy fails at once and reports the clusters and VPC peerings which still use the network container",
+				Optional: true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
 		},
 	}
 }
@@ -174,20 +203,43 @@ func (r *DedicatedNetworkContainerResource) Read(ctx context.Context, req resour
 	resp.Diagnostics.Append(diags...)
 }
 
+// NOTICE: network containers can't be updated through the API, so all the configurable attributes except
+// delete_wait_timeout require replacement. delete_wait_timeout only takes effect on destroy and is updated in place.
 func (r *DedicatedNetworkContainerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError("Update Error", "Update is not supported for dedicated network container")
+	var plan, state DedicatedNetworkContainerResourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.DeleteWaitTimeout = plan.DeleteWaitTimeout
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *DedicatedNetworkContainerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var networkContainerId string
-
-	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("network_container_id"), &networkContainerId)...)
+	var data DedicatedNetworkContainerResourceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	networkContainerId := data.NetworkContainerId.ValueString()
+
+	// the API error is opaque when the network container is still in use, so check the dependents first
+	dependents, err := r.waitNetworkContainerFree(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Unable to check the dependents of network container %s, got error: %s", networkContainerId, err))
+		return
+	}
+	if len(dependents) > 0 {
+		resp.Diagnostics.AddError("Network Container In Use",
+			fmt.Sprintf("Network container %s can't be deleted because it is still used by:\n  - %s\n"+
+				"Delete them first, or set delete_wait_timeout to wait for them to be deleted.", networkContainerId, strings.Join(dependents, "\n  - ")))
+		return
+	}
 
 	tflog.Debug(ctx, fmt.Sprintf("delete dedicated_network_container_resource network_container_id: %s", networkContainerId))
-	err := r.provider.DedicatedClient.DeleteNetworkContainer(ctx, networkContainerId)
+	err = r.provider.DedicatedClient.DeleteNetworkContainer(ctx, networkContainerId)
 	if err != nil {
 		tflog.Error(ctx, fmt.Sprintf("Unable to call DeleteNetworkContainer, error: %s", err))
 		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Unable to call DeleteNetworkContainer, got error: %s", err))
@@ -198,6 +250,76 @@ func (r *DedicatedNetworkContainerResource) ImportState(ctx context.Context, req
 	resource.ImportStatePassthroughID(ctx, path.Root("network_container_id"), req, resp)
 }
 
+// waitNetworkContainerFree returns the dependents of the network container. If delete_wait_timeout is set, it waits
+// until there are no dependents or the timeout is reached.
+func (r *DedicatedNetworkContainerResource) waitNetworkContainerFree(ctx context.Context, data DedicatedNetworkContainerResourceData) ([]string, error) {
+	dependents, err := r.retrieveNetworkContainerDependents(ctx, data)
+	if err != nil || len(dependents) == 0 || !IsKnown(data.DeleteWaitTimeout) {
+		return dependents, err
+	}
+	timeout, err := time.ParseDuration(data.DeleteWaitTimeout.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("waiting for network container %s to be free, used by: %s", data.NetworkContainerId.ValueString(), strings.Join(dependents, ", ")))
+	stateConf := &retry.StateChangeConf{
+		Pending:      []string{"in_use"},
+		Target:       []string{"free"},
+		Timeout:      timeout,
+		MinTimeout:   500 * time.Millisecond,
+		PollInterval: dedicatedNetworkContainerDeleteWaitInterval,
+		Refresh: func() (interface{}, string, error) {
+			dependents, err := r.retrieveNetworkContainerDependents(ctx, data)
+			if err != nil {
+				return nil, "", err
+			}
+			if len(dependents) > 0 {
+				return dependents, "in_use", nil
+			}
+			return dependents, "free", nil
+		},
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		var timeoutErr *retry.TimeoutError
+		if errors.As(err, &timeoutErr) {
+			// the result of the last refresh is dropped on timeout, so list the dependents which are left again
+			return r.retrieveNetworkContainerDependents(ctx, data)
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
+// retrieveNetworkContainerDependents returns the dedicated clusters and VPC peerings in the project and region of the network container.
+func (r *DedicatedNetworkContainerResource) retrieveNetworkContainerDependents(ctx context.Context, data DedicatedNetworkContainerResourceData) ([]string, error) {
+	projectId := data.ProjectId.ValueString()
+	clusters, err := (&dedicatedClustersDataSource{provider: r.provider}).retrieveClusters(ctx, projectId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to call ListClusters: %w", err)
+	}
+	vpcPeerings, err := dedicatedVpcPeeringsDataSource{provider: r.provider}.retrieveVPCPeerings(ctx, projectId, data.CloudProvider.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("unable to call ListVPCPeerings: %w", err)
+	}
+	return networkContainerDependents(data.RegionId.ValueString(), clusters, vpcPeerings), nil
+}
+
+func networkContainerDependents(regionId string, clusters []dedicated.TidbCloudOpenApidedicatedv1beta1Cluster, vpcPeerings []dedicated.Dedicatedv1beta1VpcPeering) []string {
+	var dependents []string
+	for _, c := range clusters {
+		if c.RegionId == regionId {
+			dependents = append(dependents, fmt.Sprintf("dedicated cluster %s (%s)", c.DisplayName, *c.ClusterId))
+		}
+	}
+	for _, p := range vpcPeerings {
+		if p.TidbCloudRegionId == regionId {
+			dependents = append(dependents, fmt.Sprintf("vpc peering %s to customer VPC %s", *p.VpcPeeringId, p.CustomerVpcId))
+		}
+	}
+	return dependents
+}
+
 func buildCreateDedicatedNetworkContainerBody(data DedicatedNetworkContainerResourceData) (dedicated.V1beta1NetworkContainer, error) {
 	regionId := data.RegionId.ValueString()
 	cidrNotation := data.CidrNotation.ValueString()
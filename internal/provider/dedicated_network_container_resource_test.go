@@ -2,6 +2,8 @@ package provider
 
 import (
 	"fmt"
+	"net/http"
+	"reflect"
 	"regexp"
 	"testing"
 
@@ -17,7 +19,7 @@ func TestUTDedicatedNetworkContainerResource(t *testing.T) {
 
 	ctrl := gomock.NewController(t)
 	s := mockClient.NewMockTiDBCloudDedicatedClient(ctrl)
-	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string) (tidbcloud.TiDBCloudDedicatedClient, error) {
+	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudDedicatedClient, error) {
 		return s, nil
 	})()
 
@@ -28,10 +30,79 @@ func TestUTDedicatedNetworkContainerResource(t *testing.T) {
 
 	s.EXPECT().CreateNetworkContainer(gomock.Any(), gomock.Any()).Return(&createNetworkContainerResp, nil)
 	s.EXPECT().GetNetworkContainer(gomock.Any(), gomock.Any()).Return(&getNetworkContainerResp, nil).AnyTimes()
+	s.EXPECT().ListClusters(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&dedicated.TidbCloudOpenApidedicatedv1beta1ListClustersResponse{}, nil).AnyTimes()
+	s.EXPECT().ListVPCPeerings(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&dedicated.Dedicatedv1beta1ListVpcPeeringsResponse{}, nil).AnyTimes()
 	s.EXPECT().DeleteNetworkContainer(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	testDedicatedNetworkContainerResource(t)
 }
 
+func TestUTDedicatedNetworkContainerResourceInUse(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudDedicatedClient(ctrl)
+	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudDedicatedClient, error) {
+		return s, nil
+	})()
+
+	createNetworkContainerResp := dedicated.V1beta1NetworkContainer{}
+	createNetworkContainerResp.UnmarshalJSON([]byte(testUTNetworkContainer(string(dedicated.V1BETA1NETWORKCONTAINERSTATE_INACTIVE))))
+	getNetworkContainerResp := dedicated.V1beta1NetworkContainer{}
+	getNetworkContainerResp.UnmarshalJSON([]byte(testUTNetworkContainer(string(dedicated.V1BETA1NETWORKCONTAINERSTATE_INACTIVE))))
+	clusterId := "clusterId"
+	listClustersResp := dedicated.TidbCloudOpenApidedicatedv1beta1ListClustersResponse{
+		Clusters: []dedicated.TidbCloudOpenApidedicatedv1beta1Cluster{
+			{ClusterId: &clusterId, DisplayName: "Cluster0", RegionId: "aws-ap-northeast-3"},
+		},
+	}
+
+	s.EXPECT().CreateNetworkContainer(gomock.Any(), gomock.Any()).Return(&createNetworkContainerResp, nil)
+	s.EXPECT().GetNetworkContainer(gomock.Any(), gomock.Any()).Return(&getNetworkContainerResp, nil).AnyTimes()
+	// the cluster is still in the region on the first destroy, and deleted before the second one
+	s.EXPECT().ListClusters(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&listClustersResp, nil).Times(1)
+	s.EXPECT().ListClusters(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&dedicated.TidbCloudOpenApidedicatedv1beta1ListClustersResponse{}, nil).AnyTimes()
+	s.EXPECT().ListVPCPeerings(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&dedicated.Dedicatedv1beta1ListVpcPeeringsResponse{}, nil).AnyTimes()
+	s.EXPECT().DeleteNetworkContainer(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUTDedicatedNetworkContainerResourceConfig(),
+			},
+			{
+				Config:      testUTDedicatedNetworkContainerResourceConfig(),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`Network Container In Use(.|\n)*dedicated cluster Cluster0 \(clusterId\)`),
+			},
+		},
+	})
+}
+
+func TestUTNetworkContainerDependents(t *testing.T) {
+	clusterId := "clusterId"
+	otherClusterId := "otherClusterId"
+	vpcPeeringId := "vpcPeeringId"
+	clusters := []dedicated.TidbCloudOpenApidedicatedv1beta1Cluster{
+		{ClusterId: &clusterId, DisplayName: "Cluster0", RegionId: "aws-us-west-2"},
+		{ClusterId: &otherClusterId, DisplayName: "Cluster1", RegionId: "aws-us-east-1"},
+	}
+	vpcPeerings := []dedicated.Dedicatedv1beta1VpcPeering{
+		{VpcPeeringId: &vpcPeeringId, TidbCloudRegionId: "aws-us-west-2", CustomerVpcId: "vpc-0123"},
+	}
+
+	dependents := networkContainerDependents("aws-us-west-2", clusters, vpcPeerings)
+	expected := []string{"dedicated cluster Cluster0 (clusterId)", "vpc peering vpcPeeringId to customer VPC vpc-0123"}
+	if !reflect.DeepEqual(dependents, expected) {
+		t.Errorf("expected %v, got %v", expected, dependents)
+	}
+	if dependents := networkContainerDependents("aws-eu-central-1", clusters, vpcPeerings); len(dependents) != 0 {
+		t.Errorf("expected no dependents, got %v", dependents)
+	}
+}
+
 func testDedicatedNetworkContainerResource(t *testing.T) {
 	dedicatedNetworkContainerResourceName := "tidbcloud_dedicated_network_container.test"
 	resource.Test(t, resource.TestCase{
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/dedicated"
 )
 
 type dedicatedNodeGroupDataSourceData struct {
@@ -26,6 +27,7 @@ type dedicatedNodeGroupDataSourceData struct {
 }
 
 var _ datasource.DataSource = &dedicatedNodeGroupDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &dedicatedNodeGroupDataSource{}
 
 type dedicatedNodeGroupDataSource struct {
 	provider *tidbcloudProvider
@@ -59,15 +61,17 @@ func (d *dedicatedNodeGroupDataSource) Schema(_ context.Context, _ datasource.Sc
 				Required:            true,
 			},
 			"node_group_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the node group.",
-				Required:            true,
+				MarkdownDescription: "The ID of the node group. Exactly one of node_group_id and display_name must be set.",
+				Optional:            true,
+				Computed:            true,
 			},
 			"node_count": schema.Int64Attribute{
 				MarkdownDescription: "The number of nodes in the node group.",
 				Computed:            true,
 			},
 			"display_name": schema.StringAttribute{
-				MarkdownDescription: "The display name of the node group.",
+				MarkdownDescription: "The display name of the node group. Exactly one of node_group_id and display_name must be set. An error is returned if no node group or more than one node group of the cluster has the display name.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"node_spec_key": schema.StringAttribute{
@@ -95,6 +99,10 @@ func (d *dedicatedNodeGroupDataSource) Schema(_ context.Context, _ datasource.Sc
 				MarkdownDescription: "Settings for TiProxy nodes.",
 				Computed:            true,
 				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether TiProxy is enabled.",
+						Computed:            true,
+					},
 					"node_spec_key": schema.StringAttribute{
 						MarkdownDescription: "The key of the node spec.",
 						Computed:            true,
@@ -132,6 +140,12 @@ func (d *dedicatedNodeGroupDataSource) Schema(_ context.Context, _ datasource.Sc
 	}
 }
 
+func (d *dedicatedNodeGroupDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		exactlyOneOf("node_group_id", "display_name"),
+	}
+}
+
 func (d *dedicatedNodeGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data dedicatedNodeGroupDataSourceData
 	diags := req.Config.Get(ctx, &data)
@@ -141,17 +155,35 @@ func (d *dedicatedNodeGroupDataSource) Read(ctx context.Context, req datasource.
 	}
 
 	tflog.Trace(ctx, "read node group data source")
-	nodeGroup, err := d.provider.DedicatedClient.GetTiDBNodeGroup(ctx, data.ClusterId.ValueString(), data.NodeGroupId.ValueString())
+	clusterId := data.ClusterId.ValueString()
+	nodeGroupId := data.NodeGroupId.ValueString()
+	if IsKnown(data.DisplayName) {
+		nodeGroups, err := (&dedicatedNodeGroupsDataSource{provider: d.provider}).retrieveTiDBNodeGroups(ctx, clusterId)
+		if err != nil {
+			resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to call ListTiDBNodeGroups, got error: %s", err))
+			return
+		}
+		nodeGroupId, err = resolveDisplayName(nodeGroups, data.DisplayName.ValueString(), "node group", "cluster "+clusterId,
+			func(g dedicated.Dedicatedv1beta1TidbNodeGroup) (string, string) {
+				return *g.DisplayName, *g.TidbNodeGroupId
+			})
+		if err != nil {
+			resp.Diagnostics.AddError("Read Error", err.Error())
+			return
+		}
+	}
+	nodeGroup, err := d.provider.DedicatedClient.GetTiDBNodeGroup(ctx, clusterId, nodeGroupId)
 	if err != nil {
 		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to call GetRTiDBNodeGroup, got error: %s", err))
 		return
 	}
-	publicEndpointSetting, err := d.provider.DedicatedClient.GetPublicEndpoint(ctx, data.ClusterId.ValueString(), data.NodeGroupId.ValueString())
+	publicEndpointSetting, err := d.provider.DedicatedClient.GetPublicEndpoint(ctx, clusterId, nodeGroupId)
 	if err != nil {
 		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to call GetPublicEndpoint, got error: %s", err))
 		return
 	}
 
+	data.NodeGroupId = types.StringValue(nodeGroupId)
 	data.NodeSpecKey = types.StringValue(string(*nodeGroup.NodeSpecKey))
 	data.NodeCount = types.Int64Value(int64(nodeGroup.NodeCount))
 	data.DisplayName = types.StringValue(string(*nodeGroup.DisplayName))
@@ -176,15 +208,7 @@ func (d *dedicatedNodeGroupDataSource) Read(ctx context.Context, req datasource.
 	}, endpoints)
 	diags.Append(listDiags...)
 	data.Endpoints = endpointsList
-	if nodeGroup.TiproxySetting != nil {
-		tiProxy := tiProxySetting{
-			NodeSpecKey:         types.StringValue(nodeGroup.TiproxySetting.NodeSpecKey),
-			NodeSpecVersion:     types.StringValue(*nodeGroup.TiproxySetting.NodeSpecVersion),
-			NodeCount:           types.Int32Value(*nodeGroup.TiproxySetting.NodeCount.Get()),
-			NodeSpecDisplayName: types.StringValue(*nodeGroup.TiproxySetting.NodeSpecDisplayName),
-		}
-		data.TiProxySetting = &tiProxy
-	}
+	data.TiProxySetting = convertTiProxySetting(nodeGroup.TiproxySetting)
 	data.PublicEndpointSetting = convertDedicatedPublicEndpointSetting(publicEndpointSetting)
 
 	diags = resp.State.Set(ctx, &data)
@@ -1,6 +1,9 @@
 package provider
 
 import (
+	"fmt"
+	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -31,7 +34,7 @@ func TestUTDedicatedNodeGroupDataSource(t *testing.T) {
 
 	ctrl := gomock.NewController(t)
 	s := mockClient.NewMockTiDBCloudDedicatedClient(ctrl)
-	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string) (tidbcloud.TiDBCloudDedicatedClient, error) {
+	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudDedicatedClient, error) {
 		return s, nil
 	})()
 
@@ -103,3 +106,64 @@ data "tidbcloud_dedicated_node_group" "test" {
 }
 `
 }
+
+func TestUTDedicatedNodeGroupDataSourceByDisplayName(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudDedicatedClient(ctrl)
+	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudDedicatedClient, error) {
+		return s, nil
+	})()
+
+	clusterId := "cluster_id"
+	state := string(dedicated.DEDICATEDV1BETA1TIDBNODEGROUPSTATE_ACTIVE)
+	var nodeGroups []dedicated.Dedicatedv1beta1TidbNodeGroup
+	for _, g := range []struct{ id, name string }{{"node_group_id", "test_group"}, {"dup_1", "dup"}, {"dup_2", "dup"}} {
+		nodeGroup := dedicated.Dedicatedv1beta1TidbNodeGroup{}
+		nodeGroup.UnmarshalJSON([]byte(testUTTidbCloudOpenApidedicatedv1beta1NodeGroup(clusterId, g.name, state, 1)))
+		id := g.id
+		nodeGroup.TidbNodeGroupId = &id
+		nodeGroups = append(nodeGroups, nodeGroup)
+	}
+	listNodeGroupsResp := dedicated.Dedicatedv1beta1ListTidbNodeGroupsResponse{TidbNodeGroups: nodeGroups}
+	publicEndpointResp := dedicated.V1beta1PublicEndpointSetting{}
+	publicEndpointResp.UnmarshalJSON([]byte(testUTV1beta1PublicEndpointSetting()))
+
+	s.EXPECT().ListTiDBNodeGroups(gomock.Any(), clusterId, gomock.Any(), nil).Return(&listNodeGroupsResp, nil).AnyTimes()
+	s.EXPECT().GetTiDBNodeGroup(gomock.Any(), clusterId, "node_group_id").Return(&nodeGroups[0], nil).AnyTimes()
+	s.EXPECT().GetPublicEndpoint(gomock.Any(), clusterId, "node_group_id").Return(&publicEndpointResp, nil).AnyTimes()
+
+	dedicatedNodeGroupDataSourceName := "data.tidbcloud_dedicated_node_group.test"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUTDedicatedNodeGroupDataSourceByDisplayNameConfig("test_group"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dedicatedNodeGroupDataSourceName, "node_group_id", "node_group_id"),
+					resource.TestCheckResourceAttr(dedicatedNodeGroupDataSourceName, "display_name", "test_group"),
+				),
+			},
+			{
+				Config:      testUTDedicatedNodeGroupDataSourceByDisplayNameConfig("not-exist"),
+				ExpectError: regexp.MustCompile(`no node group named "not-exist" found in cluster cluster_id`),
+			},
+			{
+				Config:      testUTDedicatedNodeGroupDataSourceByDisplayNameConfig("dup"),
+				ExpectError: regexp.MustCompile(`2 node groups named "dup" found in cluster cluster_id: dup_1, dup_2`),
+			},
+		},
+	})
+}
+
+func testUTDedicatedNodeGroupDataSourceByDisplayNameConfig(displayName string) string {
+	return fmt.Sprintf(`
+data "tidbcloud_dedicated_node_group" "test" {
+	cluster_id   = "cluster_id"
+	display_name = "%s"
+}
+`, displayName)
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/dedicated"
+)
+
+type dedicatedNodeGroupListConfig struct {
+	ClusterId types.String `tfsdk:"cluster_id"`
+}
+
+type dedicatedNodeGroupListResource struct {
+	provider *tidbcloudProvider
+}
+
+var _ list.ListResourceWithConfigure = &dedicatedNodeGroupListResource{}
+
+func NewDedicatedNodeGroupListResource() list.ListResource {
+	return &dedicatedNodeGroupListResource{}
+}
+
+func (r *dedicatedNodeGroupListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dedicated_node_group"
+}
+
+func (r *dedicatedNodeGroupListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	var ok bool
+	if r.provider, ok = req.ProviderData.(*tidbcloudProvider); !ok {
+		resp.Diagnostics.AddError("Internal provider error",
+			fmt.Sprintf("Error in Configure: expected %T but got %T", tidbcloudProvider{}, req.ProviderData))
+	}
+}
+
+func (r *dedicatedNodeGroupListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the TiDB node groups of a dedicated cluster. The default node group is managed by tidbcloud_dedicated_cluster, so it is not listed.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the cluster.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *dedicatedNodeGroupListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config dedicatedNodeGroupListConfig
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Trace(ctx, "list dedicated_node_group_resource")
+	nodeGroups, err := (&dedicatedNodeGroupsDataSource{provider: r.provider}).retrieveTiDBNodeGroups(ctx, config.ClusterId.ValueString())
+	if err != nil {
+		stream.Results = listError("List Error", fmt.Sprintf("Unable to call ListTiDBNodeGroups, got error: %s", err))
+		return
+	}
+	var items []dedicated.Dedicatedv1beta1TidbNodeGroup
+	for _, nodeGroup := range nodeGroups {
+		if nodeGroup.IsDefaultGroup != nil && *nodeGroup.IsDefaultGroup {
+			continue
+		}
+		items = append(items, nodeGroup)
+	}
+
+	stream.Results = listResults(ctx, req, items, func(nodeGroup dedicated.Dedicatedv1beta1TidbNodeGroup, result *list.ListResult) {
+		result.DisplayName = *nodeGroup.DisplayName
+		result.Diagnostics.Append(result.Identity.Set(ctx, dedicatedNodeGroupResourceIdentity{
+			ClusterId:   config.ClusterId,
+			NodeGroupId: types.StringValue(*nodeGroup.TidbNodeGroupId),
+		})...)
+		if !req.IncludeResource {
+			return
+		}
+		data := dedicatedNodeGroupResourceData{
+			ClusterId:   config.ClusterId,
+			NodeGroupId: types.StringValue(*nodeGroup.TidbNodeGroupId),
+		}
+		refreshDedicatedNodeGroupResourceData(&nodeGroup, &data)
+		result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+	})
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	mockClient "github.com/tidbcloud/terraform-provider-tidbcloud/mock"
+	"github.com/tidbcloud/terraform-provider-tidbcloud/tidbcloud"
+	"github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/dedicated"
+)
+
+func TestUTDedicatedNodeGroupListResource(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudDedicatedClient(ctrl)
+	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudDedicatedClient, error) {
+		return s, nil
+	})()
+
+	var resp dedicated.Dedicatedv1beta1ListTidbNodeGroupsResponse
+	resp.UnmarshalJSON([]byte(testUTListDedicatedv1beta1TidbNodeGroupResp))
+	publicEndpointResp := dedicated.V1beta1PublicEndpointSetting{}
+	publicEndpointResp.UnmarshalJSON([]byte(testUTV1beta1PublicEndpointSetting()))
+
+	s.EXPECT().ListTiDBNodeGroups(gomock.Any(), "cluster_id", gomock.Any(), nil).Return(&resp, nil).AnyTimes()
+	s.EXPECT().GetPublicEndpoint(gomock.Any(), gomock.Any(), gomock.Any()).Return(&publicEndpointResp, nil).AnyTimes()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		PreCheck:   func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUTDedicatedNodeGroupsConfig(),
+			},
+			{
+				// the default node group is managed by the cluster and not listed.
+				Query: true,
+				Config: `
+provider "tidbcloud" {}
+
+list "tidbcloud_dedicated_node_group" "test" {
+  provider = tidbcloud
+
+  config {
+    cluster_id = "cluster_id"
+  }
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("tidbcloud_dedicated_node_group.test", 1),
+					querycheck.ExpectIdentity("tidbcloud_dedicated_node_group.test", map[string]knownvalue.Check{
+						"cluster_id":    knownvalue.StringExact("cluster_id"),
+						"node_group_id": knownvalue.StringExact("192"),
+					}),
+				},
+			},
+		},
+	})
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -10,12 +11,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -35,12 +38,23 @@ type dedicatedNodeGroupResourceData struct {
 	Endpoints             types.List             `tfsdk:"endpoints"`
 	TiProxySetting        *tiProxySetting        `tfsdk:"tiproxy_setting"`
 	PublicEndpointSetting *publicEndpointSetting `tfsdk:"public_endpoint_setting"`
+	MinNodeCount          types.Int32            `tfsdk:"min_node_count"`
+	AllowScaleDown        types.Bool             `tfsdk:"allow_scale_down"`
+	ScaleStep             types.Int32            `tfsdk:"scale_step"`
+	AdoptDefaultGroup     types.Bool             `tfsdk:"adopt_default_group"`
+}
+
+type dedicatedNodeGroupResourceIdentity struct {
+	ClusterId   types.String `tfsdk:"cluster_id"`
+	NodeGroupId types.String `tfsdk:"node_group_id"`
 }
 
 type dedicatedNodeGroupResource struct {
 	provider *tidbcloudProvider
 }
 
+var _ resource.ResourceWithModifyPlan = &dedicatedNodeGroupResource{}
+
 func NewDedicatedNodeGroupResource() resource.Resource {
 	return &dedicatedNodeGroupResource{}
 }
@@ -71,13 +85,38 @@ func (r *dedicatedNodeGroupResource) Schema(_ context.Context, _ resource.Schema
 				Required:            true,
 			},
 			"node_spec_key": schema.StringAttribute{
-				MarkdownDescription: "The key of the node spec.",
+				MarkdownDescription: "The key of the node spec. The node spec is shared by all the TiDB node groups of the cluster and is changed through tidb_node_setting.node_spec_key of the cluster, so it must match the node spec of the cluster. Setting it lets a change of the cluster node spec be applied to the node group in place, without recreating the node group or its endpoints.",
+				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"node_count": schema.Int32Attribute{
 				MarkdownDescription: "The count of the nodes in the group.",
 				Required:            true,
 			},
+			"min_node_count": schema.Int32Attribute{
+				MarkdownDescription: "The minimum count of the nodes in the group. A plan with a smaller node_count is rejected.",
+				Optional:            true,
+				Validators:          []validator.Int32{atLeastInt32Validator{min: 1}},
+			},
+			"allow_scale_down": schema.BoolAttribute{
+				MarkdownDescription: "Whether node_count can be decreased in place. Set it to false to reject any plan which removes nodes from the group. Default is true.",
+				Optional:            true,
+			},
+			"scale_step": schema.Int32Attribute{
+				MarkdownDescription: "The maximum number of nodes added or removed at a time. When set, node_count is changed step by step, and the node group must be ready again before the next step. By default, node_count is changed at once.",
+				Optional:            true,
+				Validators:          []validator.Int32{atLeastInt32Validator{min: 1}},
+			},
+			"adopt_default_group": schema.BoolAttribute{
+				MarkdownDescription: "Whether to take over the default node group of the cluster instead of creating a new node group. The settings of the default group are then managed by this resource, so set ignore_default_group_changes of the cluster to true. Changing it recreates the resource, and the default group is never deleted by this resource.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
 			"node_group_id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the node group.",
 				Computed:            true,
@@ -113,8 +152,12 @@ func (r *dedicatedNodeGroupResource) Schema(_ context.Context, _ resource.Schema
 				MarkdownDescription: "Settings for TiProxy nodes.",
 				Optional:            true,
 				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether TiProxy is enabled. Set it to false to disable TiProxy and keep the settings. Removing the block also disables TiProxy. Default is true.",
+						Optional:            true,
+					},
 					"node_spec_key": schema.StringAttribute{
-						MarkdownDescription: "The key of the node spec.",
+						MarkdownDescription: "The key of the node spec, e.g. 2C4G.",
 						Required:            true,
 					},
 					"node_spec_version": schema.StringAttribute{
@@ -124,6 +167,7 @@ func (r *dedicatedNodeGroupResource) Schema(_ context.Context, _ resource.Schema
 					"node_count": schema.Int32Attribute{
 						MarkdownDescription: "The number of TiProxy nodes.",
 						Required:            true,
+						Validators:          []validator.Int32{atLeastInt32Validator{min: 1}},
 					},
 					"node_spec_display_name": schema.StringAttribute{
 						MarkdownDescription: "The display name of the node spec.",
@@ -162,6 +206,163 @@ func (r *dedicatedNodeGroupResource) Schema(_ context.Context, _ resource.Schema
 	}
 }
 
+func (r *dedicatedNodeGroupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"cluster_id": identityschema.StringAttribute{
+				Description:       "The ID of the cluster.",
+				RequiredForImport: true,
+			},
+			"node_group_id": identityschema.StringAttribute{
+				Description:       "The ID of the node group.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// ModifyPlan rejects plans which break the scaling safeguards, i.e. min_node_count and allow_scale_down,
+// and checks a new node_spec_key and tiproxy_setting.node_spec_key against the node specs of the region.
+func (r *dedicatedNodeGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan dedicatedNodeGroupResourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || !IsKnown(plan.NodeCount) {
+		return
+	}
+	nodeCount := plan.NodeCount.ValueInt32()
+	if IsKnown(plan.MinNodeCount) && nodeCount < plan.MinNodeCount.ValueInt32() {
+		resp.Diagnostics.AddAttributeError(path.Root("node_count"), "Invalid Node Count",
+			fmt.Sprintf("node_count %d is less than min_node_count %d.", nodeCount, plan.MinNodeCount.ValueInt32()))
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		if plan.AdoptDefaultGroup.ValueBool() {
+			// ignore_default_group_changes is only known by the cluster resource, so it can't be checked here
+			resp.Diagnostics.AddAttributeWarning(path.Root("adopt_default_group"), "Default Group Managed By Two Resources",
+				fmt.Sprintf("The default node group of cluster %s is taken over by this resource. Set ignore_default_group_changes of the tidbcloud_dedicated_cluster resource to true, "+
+					"otherwise the cluster resource reverts the changes of this resource to the default group.", plan.ClusterId.ValueString()))
+		}
+		if IsKnown(plan.NodeSpecKey) {
+			r.checkNodeSpec(ctx, plan, true, &resp.Diagnostics)
+		}
+		if plan.TiProxySetting != nil && IsKnown(plan.TiProxySetting.NodeSpecKey) {
+			r.checkTiProxyNodeSpec(ctx, plan, &resp.Diagnostics)
+		}
+		return
+	}
+	var state dedicatedNodeGroupResourceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if IsKnown(plan.AllowScaleDown) && !plan.AllowScaleDown.ValueBool() && nodeCount < state.NodeCount.ValueInt32() {
+		resp.Diagnostics.AddAttributeError(path.Root("node_count"), "Scale Down Not Allowed",
+			fmt.Sprintf("The node group %s would be scaled down from %d to %d nodes, but allow_scale_down is false. Set allow_scale_down to true to remove nodes from the group.",
+				state.NodeGroupId.ValueString(), state.NodeCount.ValueInt32(), nodeCount))
+		return
+	}
+	if IsKnown(plan.NodeSpecKey) && !plan.NodeSpecKey.Equal(state.NodeSpecKey) {
+		r.checkNodeSpec(ctx, plan, false, &resp.Diagnostics)
+	}
+	if plan.TiProxySetting != nil && IsKnown(plan.TiProxySetting.NodeSpecKey) &&
+		(state.TiProxySetting == nil || !plan.TiProxySetting.NodeSpecKey.Equal(state.TiProxySetting.NodeSpecKey)) {
+		r.checkTiProxyNodeSpec(ctx, plan, &resp.Diagnostics)
+	}
+}
+
+// checkNodeSpec checks the planned node_spec_key against the cluster. A new node group must use the node spec
+// of the cluster, while a changed node spec must be one of the TiDB node specs of the region. The node spec is
+// shared by all the TiDB node groups, so it is changed through the cluster, and a changed node_spec_key only
+// follows tidb_node_setting.node_spec_key of the cluster.
+func (r *dedicatedNodeGroupResource) checkNodeSpec(ctx context.Context, plan dedicatedNodeGroupResourceData, creating bool, diags *diag.Diagnostics) {
+	if r.provider == nil || !r.provider.configured || !IsKnown(plan.ClusterId) {
+		return
+	}
+	nodeSpecKey := plan.NodeSpecKey.ValueString()
+	cluster, err := r.provider.DedicatedClient.GetCluster(ctx, plan.ClusterId.ValueString())
+	if err != nil {
+		diags.AddWarning("Unable to check node spec", fmt.Sprintf("Unable to call GetCluster, got error: %s", err))
+		return
+	}
+	clusterNodeSpecKey := ""
+	if cluster.TidbNodeSetting != nil && cluster.TidbNodeSetting.NodeSpecKey != nil {
+		clusterNodeSpecKey = *cluster.TidbNodeSetting.NodeSpecKey
+	}
+	if creating {
+		if clusterNodeSpecKey != "" && clusterNodeSpecKey != nodeSpecKey {
+			diags.AddAttributeError(path.Root("node_spec_key"), "Invalid Node Spec",
+				fmt.Sprintf("A new node group uses the node spec %s of cluster %s, got: %s. Change tidb_node_setting.node_spec_key of the cluster to change the node spec of its node groups.",
+					clusterNodeSpecKey, plan.ClusterId.ValueString(), nodeSpecKey))
+		}
+		return
+	}
+
+	specs, err := r.provider.listDedicatedNodeSpecs(ctx, cluster.RegionId)
+	if err != nil {
+		diags.AddWarning("Unable to check node spec", fmt.Sprintf("Unable to call ListNodeSpecs, got error: %s", err))
+		return
+	}
+	nodeSpecKeys := dedicatedNodeSpecKeys(specs, dedicatedComponentTypeTiDB)
+	if len(nodeSpecKeys) == 0 {
+		diags.AddWarning("Unable to check node spec", fmt.Sprintf("No TiDB node specs found for region %s, node_spec_key %s is not checked.", cluster.RegionId, nodeSpecKey))
+	} else if !slices.Contains(nodeSpecKeys, nodeSpecKey) {
+		diags.AddAttributeError(path.Root("node_spec_key"), "Invalid Node Spec",
+			fmt.Sprintf("Node spec %s is not available for TiDB in region %s, available node specs: %s.", nodeSpecKey, cluster.RegionId, strings.Join(nodeSpecKeys, ", ")))
+		return
+	}
+	if clusterNodeSpecKey != nodeSpecKey {
+		diags.AddAttributeWarning(path.Root("node_spec_key"), "Node Spec Is Changed Through The Cluster",
+			fmt.Sprintf("The node spec is shared by all the TiDB node groups of cluster %s, and the cluster uses %s. The apply fails unless tidb_node_setting.node_spec_key of the cluster is changed to %s first, e.g. in the same apply.",
+				plan.ClusterId.ValueString(), clusterNodeSpecKey, nodeSpecKey))
+	}
+}
+
+// checkTiProxyNodeSpec checks the planned tiproxy_setting.node_spec_key against the TiProxy node specs of the region.
+func (r *dedicatedNodeGroupResource) checkTiProxyNodeSpec(ctx context.Context, plan dedicatedNodeGroupResourceData, diags *diag.Diagnostics) {
+	if r.provider == nil || !r.provider.configured || !IsKnown(plan.ClusterId) {
+		return
+	}
+	nodeSpecKey := plan.TiProxySetting.NodeSpecKey.ValueString()
+	cluster, err := r.provider.DedicatedClient.GetCluster(ctx, plan.ClusterId.ValueString())
+	if err != nil {
+		diags.AddWarning("Unable to check node spec", fmt.Sprintf("Unable to call GetCluster, got error: %s", err))
+		return
+	}
+	specs, err := r.provider.listDedicatedNodeSpecs(ctx, cluster.RegionId)
+	if err != nil {
+		diags.AddWarning("Unable to check node spec", fmt.Sprintf("Unable to call ListNodeSpecs, got error: %s", err))
+		return
+	}
+	nodeSpecKeys := dedicatedNodeSpecKeys(specs, dedicatedComponentTypeTiProxy)
+	if len(nodeSpecKeys) == 0 {
+		diags.AddWarning("Unable to check node spec", fmt.Sprintf("No TiProxy node specs found for region %s, tiproxy_setting.node_spec_key %s is not checked.", cluster.RegionId, nodeSpecKey))
+	} else if !slices.Contains(nodeSpecKeys, nodeSpecKey) {
+		diags.AddAttributeError(path.Root("tiproxy_setting").AtName("node_spec_key"), "Invalid Node Spec",
+			fmt.Sprintf("Node spec %s is not available for TiProxy in region %s, available node specs: %s.", nodeSpecKey, cluster.RegionId, strings.Join(nodeSpecKeys, ", ")))
+	}
+}
+
+// dedicatedComponentTypeTiDB and dedicatedComponentTypeTiProxy are the component types of the node specs.
+const (
+	dedicatedComponentTypeTiDB    = "TIDB"
+	dedicatedComponentTypeTiProxy = "TIPROXY"
+)
+
+// dedicatedNodeSpecKeys returns the keys of the node specs of a component type, e.g. TIDB.
+func dedicatedNodeSpecKeys(specs []dedicated.Dedicatedv1beta1NodeSpec, componentType string) []string {
+	var nodeSpecKeys []string
+	for _, spec := range specs {
+		if string(spec.GetComponentType()) == componentType {
+			nodeSpecKeys = append(nodeSpecKeys, spec.GetNodeSpecKey())
+		}
+	}
+	return nodeSpecKeys
+}
+
 func (r dedicatedNodeGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if !r.provider.configured {
 		resp.Diagnostics.AddError(
@@ -179,27 +380,39 @@ func (r dedicatedNodeGroupResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
-	tflog.Trace(ctx, "create dedicated_node_group_resource")
-	body := buildCreateDedicatedNodeGroupBody(data)
-	nodeGroup, err := r.provider.DedicatedClient.CreateTiDBNodeGroup(ctx, data.ClusterId.ValueString(), &body)
-	if err != nil {
-		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to call CreateTiDBNodeGroup, got error: %s", err))
-		return
-	}
-	// set tidbNodeGroupId. other computed attributes are not returned by create, they will be set when refresh
-	nodeGroupId := *nodeGroup.TidbNodeGroupId
-	data.NodeGroupId = types.StringValue(nodeGroupId)
-	tflog.Info(ctx, "wait dedicated node group ready")
-	// it's a workaround, tidb node group state is active at the beginning, so we need to wait for it to be modifying
-	time.Sleep(1 * time.Minute)
+	var nodeGroupId string
+	if data.AdoptDefaultGroup.ValueBool() {
+		tflog.Trace(ctx, "adopt default group by dedicated_node_group_resource")
+		nodeGroup, err := r.adoptDefaultGroup(ctx, data)
+		if err != nil {
+			resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to adopt the default node group of cluster %s, got error: %s", data.ClusterId.ValueString(), err))
+			return
+		}
+		nodeGroupId = *nodeGroup.TidbNodeGroupId
+		data.NodeGroupId = types.StringValue(nodeGroupId)
+	} else {
+		tflog.Trace(ctx, "create dedicated_node_group_resource")
+		body := buildCreateDedicatedNodeGroupBody(data)
+		nodeGroup, err := r.provider.DedicatedClient.CreateTiDBNodeGroup(ctx, data.ClusterId.ValueString(), &body)
+		if err != nil {
+			resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to call CreateTiDBNodeGroup, got error: %s", err))
+			return
+		}
+		// set tidbNodeGroupId. other computed attributes are not returned by create, they will be set when refresh
+		nodeGroupId = *nodeGroup.TidbNodeGroupId
+		data.NodeGroupId = types.StringValue(nodeGroupId)
+		tflog.Info(ctx, "wait dedicated node group ready")
+		// it's a workaround, tidb node group state is active at the beginning, so we need to wait for it to be modifying
+		time.Sleep(1 * time.Minute)
 
-	_, err = WaitDedicatedNodeGroupReady(ctx, clusterCreateTimeout, clusterCreateInterval, data.ClusterId.ValueString(), nodeGroupId, r.provider.DedicatedClient)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Node group creation failed",
-			fmt.Sprintf("Node group is not ready, get error: %s", err),
-		)
-		return
+		_, err = WaitDedicatedNodeGroupReady(ctx, clusterCreateTimeout, clusterCreateInterval, data.ClusterId.ValueString(), nodeGroupId, r.provider.DedicatedClient)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Node group creation failed",
+				fmt.Sprintf("Node group is not ready, get error: %s", err),
+			)
+			return
+		}
 	}
 
 	// using tidb node group api create public endpoint setting
@@ -212,7 +425,7 @@ func (r dedicatedNodeGroupResource) Create(ctx context.Context, req resource.Cre
 
 	// sleep 1 minute to wait the endpoints updated, then get cluster to refresh the endpoints
 	time.Sleep(1 * time.Minute)
-	nodeGroup, err = r.provider.DedicatedClient.GetTiDBNodeGroup(ctx, data.ClusterId.ValueString(), nodeGroupId)
+	nodeGroup, err := r.provider.DedicatedClient.GetTiDBNodeGroup(ctx, data.ClusterId.ValueString(), nodeGroupId)
 	if err != nil {
 		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to call GetCluster, got error: %s", err))
 		return
@@ -222,6 +435,7 @@ func (r dedicatedNodeGroupResource) Create(ctx context.Context, req resource.Cre
 	// save into the Terraform state.
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, dedicatedNodeGroupResourceIdentity{ClusterId: data.ClusterId, NodeGroupId: data.NodeGroupId})...)
 }
 
 func (r dedicatedNodeGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -254,6 +468,7 @@ func (r dedicatedNodeGroupResource) Read(ctx context.Context, req resource.ReadR
 	// save into the Terraform state.
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, dedicatedNodeGroupResourceIdentity{ClusterId: data.ClusterId, NodeGroupId: data.NodeGroupId})...)
 }
 
 func (r dedicatedNodeGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -266,6 +481,18 @@ func (r dedicatedNodeGroupResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	var isDefaultGroup types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("is_default_group"), &isDefaultGroup)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if isDefaultGroup.ValueBool() {
+		// the default group lives as long as the cluster, only remove it from state
+		resp.Diagnostics.AddWarning("Default Node Group Not Deleted",
+			fmt.Sprintf("Node group %s is the default node group of cluster %s. It is removed from the state, and is deleted together with the cluster.", nodeGroupId, clusterId))
+		return
+	}
+
 	tflog.Trace(ctx, "delete dedicated_node_group_resource")
 	err := r.provider.DedicatedClient.DeleteTiDBNodeGroup(ctx, clusterId, nodeGroupId)
 	if err != nil {
@@ -311,38 +538,84 @@ func (r dedicatedNodeGroupResource) Update(ctx context.Context, req resource.Upd
 		time.Sleep(1 * time.Minute)
 	}
 
-	newDisplayName := plan.DisplayName.ValueString()
-	newNodeCount := int32(plan.NodeCount.ValueInt32())
-	body := dedicated.TidbNodeGroupServiceUpdateTidbNodeGroupRequest{
-		DisplayName: &newDisplayName,
-		NodeCount:   *dedicated.NewNullableInt32(&newNodeCount),
+	scaleStep := int32(0)
+	if IsKnown(plan.ScaleStep) {
+		scaleStep = plan.ScaleStep.ValueInt32()
+	}
+	state.MinNodeCount = plan.MinNodeCount
+	state.AllowScaleDown = plan.AllowScaleDown
+	state.ScaleStep = plan.ScaleStep
+
+	if IsKnown(plan.NodeSpecKey) && !plan.NodeSpecKey.Equal(state.NodeSpecKey) {
+		// the node spec belongs to the cluster, and changing it here would drift the cluster resource, so the
+		// node group only follows a node spec which is already changed through the cluster
+		cluster, err := r.provider.DedicatedClient.GetCluster(ctx, state.ClusterId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to call GetCluster, got error: %s", err))
+			return
+		}
+		if cluster.TidbNodeSetting == nil || cluster.TidbNodeSetting.NodeSpecKey == nil || *cluster.TidbNodeSetting.NodeSpecKey != plan.NodeSpecKey.ValueString() {
+			resp.Diagnostics.AddAttributeError(path.Root("node_spec_key"), "Node Spec Is Changed Through The Cluster",
+				fmt.Sprintf("The node spec is shared by all the TiDB node groups of cluster %s, so node_spec_key of a node group can't be changed on its own. "+
+					"Change tidb_node_setting.node_spec_key of the tidbcloud_dedicated_cluster resource to %s instead.", state.ClusterId.ValueString(), plan.NodeSpecKey.ValueString()))
+			return
+		}
+		tflog.Info(ctx, "wait node group ready after the node spec of the cluster is changed")
+		if _, err := WaitDedicatedNodeGroupReady(ctx, clusterUpdateTimeout, clusterUpdateInterval, state.ClusterId.ValueString(), state.NodeGroupId.ValueString(), r.provider.DedicatedClient); err != nil {
+			resp.Diagnostics.AddError("Node group update failed", fmt.Sprintf("Node Group is not ready, get error: %s", err))
+			return
+		}
 	}
 
-	if plan.TiProxySetting != nil {
-		tiProxySetting := dedicated.Dedicatedv1beta1TidbNodeGroupTiProxySetting{}
-		tiProxyNodeCount := plan.TiProxySetting.NodeCount.ValueInt32()
-		tiProxyNodeSpecKey := plan.TiProxySetting.NodeSpecKey.ValueString()
-		tiProxySetting.NodeCount = *dedicated.NewNullableInt32(&tiProxyNodeCount)
-		tiProxySetting.NodeSpecKey = tiProxyNodeSpecKey
-		body.TiproxySetting = &tiProxySetting
+	if tiProxySettingChanged(plan.TiProxySetting, state.TiProxySetting) {
+		// update TiProxy on its own, so the TiDB nodes are not touched
+		err := updateTiProxySetting(ctx, r.provider.DedicatedClient, state.ClusterId.ValueString(), state.NodeGroupId.ValueString(), plan.TiProxySetting, state.TiProxySetting)
+		if err != nil {
+			resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update TiProxy setting, got error: %s", err))
+			return
+		}
 	}
+	state.TiProxySetting = plan.TiProxySetting
 
-	// call update api
-	tflog.Trace(ctx, "update dedicated_node_group_resource")
-	_, err := r.provider.DedicatedClient.UpdateTiDBNodeGroup(ctx, state.ClusterId.ValueString(), plan.NodeGroupId.ValueString(), &body)
-	if err != nil {
-		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to call UpdateTiDBNodeGroup, got error: %s", err))
-		return
+	newDisplayName := plan.DisplayName.ValueString()
+	var nodeGroup *dedicated.Dedicatedv1beta1TidbNodeGroup
+	var steps []int32
+	if !plan.DisplayName.Equal(state.DisplayName) || !plan.NodeCount.Equal(state.NodeCount) {
+		steps = nodeCountSteps(state.NodeCount.ValueInt32(), plan.NodeCount.ValueInt32(), scaleStep)
 	}
+	for i, nodeCount := range steps {
+		body := dedicated.TidbNodeGroupServiceUpdateTidbNodeGroupRequest{
+			DisplayName: &newDisplayName,
+			NodeCount:   *dedicated.NewNullableInt32(&nodeCount),
+		}
 
-	tflog.Info(ctx, "wait node group ready")
-	nodeGroup, err := WaitDedicatedNodeGroupReady(ctx, clusterUpdateTimeout, clusterUpdateInterval, state.ClusterId.ValueString(), state.NodeGroupId.ValueString(), r.provider.DedicatedClient)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Node group update failed",
-			fmt.Sprintf("Node Group is not ready, get error: %s", err),
-		)
-		return
+		// call update api
+		tflog.Trace(ctx, "update dedicated_node_group_resource")
+		_, err := r.provider.DedicatedClient.UpdateTiDBNodeGroup(ctx, state.ClusterId.ValueString(), plan.NodeGroupId.ValueString(), &body)
+		if err != nil {
+			resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to call UpdateTiDBNodeGroup, got error: %s", err))
+			r.saveScalingProgress(ctx, nodeGroup, &state, resp)
+			return
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("wait node group ready, step %d/%d, node count %d", i+1, len(steps), nodeCount))
+		nodeGroup, err = WaitDedicatedNodeGroupReady(ctx, clusterUpdateTimeout, clusterUpdateInterval, state.ClusterId.ValueString(), state.NodeGroupId.ValueString(), r.provider.DedicatedClient)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Node group update failed",
+				fmt.Sprintf("Node Group is not ready, get error: %s", err),
+			)
+			r.saveScalingProgress(ctx, nodeGroup, &state, resp)
+			return
+		}
+	}
+	if nodeGroup == nil {
+		var err error
+		nodeGroup, err = r.provider.DedicatedClient.GetTiDBNodeGroup(ctx, state.ClusterId.ValueString(), state.NodeGroupId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to call GetTiDBNodeGroup, got error: %s", err))
+			return
+		}
 	}
 
 	refreshDedicatedNodeGroupResourceData(nodeGroup, &state)
@@ -353,19 +626,61 @@ func (r dedicatedNodeGroupResource) Update(ctx context.Context, req resource.Upd
 
 }
 
-func (r dedicatedNodeGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+// adoptDefaultGroup finds the default node group of the cluster, and applies display_name, node_count and
+// tiproxy_setting to it if they differ.
+func (r dedicatedNodeGroupResource) adoptDefaultGroup(ctx context.Context, data dedicatedNodeGroupResourceData) (*dedicated.Dedicatedv1beta1TidbNodeGroup, error) {
+	clusterId := data.ClusterId.ValueString()
+	nodeGroups, err := (&dedicatedNodeGroupsDataSource{provider: r.provider}).retrieveTiDBNodeGroups(ctx, clusterId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to call ListTiDBNodeGroups, got error: %s", err)
+	}
+	var defaultGroup *dedicated.Dedicatedv1beta1TidbNodeGroup
+	for i := range nodeGroups {
+		if nodeGroups[i].IsDefaultGroup != nil && *nodeGroups[i].IsDefaultGroup {
+			defaultGroup = &nodeGroups[i]
+			break
+		}
+	}
+	if defaultGroup == nil {
+		return nil, fmt.Errorf("no default node group found")
+	}
+	nodeGroupId := *defaultGroup.TidbNodeGroupId
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: cluster_id, node_group_id. Got: %q", req.ID),
-		)
+	displayName := data.DisplayName.ValueString()
+	nodeCount := data.NodeCount.ValueInt32()
+	currentTiProxySetting := convertTiProxySetting(defaultGroup.TiproxySetting)
+	isTiProxySettingChanging := tiProxySettingChanged(data.TiProxySetting, currentTiProxySetting)
+	if (defaultGroup.DisplayName != nil && *defaultGroup.DisplayName == displayName) && defaultGroup.NodeCount == nodeCount && !isTiProxySettingChanging {
+		return defaultGroup, nil
+	}
+	body := dedicated.TidbNodeGroupServiceUpdateTidbNodeGroupRequest{
+		DisplayName: &displayName,
+		NodeCount:   *dedicated.NewNullableInt32(&nodeCount),
+	}
+	if isTiProxySettingChanging {
+		body.TiproxySetting = buildTiProxySetting(data.TiProxySetting, currentTiProxySetting)
+	}
+	if _, err := r.provider.DedicatedClient.UpdateTiDBNodeGroup(ctx, clusterId, nodeGroupId, &body); err != nil {
+		return nil, fmt.Errorf("unable to call UpdateTiDBNodeGroup, got error: %s", err)
+	}
+	tflog.Info(ctx, "wait default node group ready")
+	// it's a workaround, tidb node group state is active at the beginning, so we need to wait for it to be modifying
+	time.Sleep(1 * time.Minute)
+	return WaitDedicatedNodeGroupReady(ctx, clusterUpdateTimeout, clusterUpdateInterval, clusterId, nodeGroupId, r.provider.DedicatedClient)
+}
+
+// saveScalingProgress saves the node group of the last finished step when a stepwise update fails,
+// so that the next plan starts from the actual node count.
+func (r dedicatedNodeGroupResource) saveScalingProgress(ctx context.Context, nodeGroup *dedicated.Dedicatedv1beta1TidbNodeGroup, state *dedicatedNodeGroupResourceData, resp *resource.UpdateResponse) {
+	if nodeGroup == nil {
 		return
 	}
+	refreshDedicatedNodeGroupResourceData(nodeGroup, state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("node_group_id"), idParts[1])...)
+func (r dedicatedNodeGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateByFields(ctx, "tidbcloud_dedicated_node_group", req, resp)
 }
 
 func buildCreateDedicatedNodeGroupBody(data dedicatedNodeGroupResourceData) dedicated.TidbNodeGroupServiceCreateTidbNodeGroupRequest {
@@ -376,7 +691,7 @@ func buildCreateDedicatedNodeGroupBody(data dedicatedNodeGroupResourceData) dedi
 		NodeCount:   nodeCount,
 	}
 
-	if data.TiProxySetting != nil {
+	if tiProxyEnabled(data.TiProxySetting) {
 		tiProxySetting := dedicated.Dedicatedv1beta1TidbNodeGroupTiProxySetting{}
 		tiProxyNodeCount := data.TiProxySetting.NodeCount.ValueInt32()
 		tiProxyNodeSpecKey := data.TiProxySetting.NodeSpecKey.ValueString()
@@ -388,6 +703,24 @@ func buildCreateDedicatedNodeGroupBody(data dedicatedNodeGroupResourceData) dedi
 	return nodeGroup
 }
 
+// nodeCountSteps returns the node counts to go through from current to target, changing at most step nodes at a time.
+// A step less than 1 means changing to target at once.
+func nodeCountSteps(current int32, target int32, step int32) []int32 {
+	if step < 1 || current == target {
+		return []int32{target}
+	}
+	var steps []int32
+	for current != target {
+		if target > current {
+			current = min(current+step, target)
+		} else {
+			current = max(current-step, target)
+		}
+		steps = append(steps, current)
+	}
+	return steps
+}
+
 func refreshDedicatedNodeGroupResourceData(resp *dedicated.Dedicatedv1beta1TidbNodeGroup, data *dedicatedNodeGroupResourceData) {
 	data.DisplayName = types.StringValue(*resp.DisplayName)
 	data.NodeSpecDisplayName = types.StringValue(*resp.NodeSpecDisplayName)
@@ -412,14 +745,7 @@ func refreshDedicatedNodeGroupResourceData(resp *dedicated.Dedicatedv1beta1TidbN
 	endpointsList, listDiags := types.ListValue(types.ObjectType{
 		AttrTypes: endpointItemAttrTypes,
 	}, endpoints)
-	if resp.TiproxySetting != nil {
-		data.TiProxySetting = &tiProxySetting{
-			NodeSpecKey:         types.StringValue(resp.TiproxySetting.NodeSpecKey),
-			NodeSpecVersion:     types.StringValue(*resp.TiproxySetting.NodeSpecVersion),
-			NodeCount:           types.Int32Value(*resp.TiproxySetting.NodeCount.Get()),
-			NodeSpecDisplayName: types.StringValue(*resp.TiproxySetting.NodeSpecDisplayName),
-		}
-	}
+	data.TiProxySetting = refreshTiProxySetting(resp.TiproxySetting, data.TiProxySetting)
 	diags.Append(listDiags...)
 	data.Endpoints = endpointsList
 }
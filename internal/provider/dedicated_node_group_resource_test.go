@@ -1,11 +1,19 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	mockClient "github.com/tidbcloud/terraform-provider-tidbcloud/mock"
 	"github.com/tidbcloud/terraform-provider-tidbcloud/tidbcloud"
 	"github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/dedicated"
@@ -42,7 +50,7 @@ func TestUTDedicatedNodeGroupResource(t *testing.T) {
 
 	ctrl := gomock.NewController(t)
 	s := mockClient.NewMockTiDBCloudDedicatedClient(ctrl)
-	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string) (tidbcloud.TiDBCloudDedicatedClient, error) {
+	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudDedicatedClient, error) {
 		return s, nil
 	})()
 
@@ -75,6 +83,341 @@ func TestUTDedicatedNodeGroupResource(t *testing.T) {
 	testDedicatedNodeGroupResource(t)
 }
 
+func TestUTDedicatedNodeGroupResourceImportByIdentity(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudDedicatedClient(ctrl)
+	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudDedicatedClient, error) {
+		return s, nil
+	})()
+
+	clusterId := "cluster_id"
+	displayName := "test_group"
+	nodeGroupId := "node_group_id"
+
+	createNodeGroupResp := dedicated.Dedicatedv1beta1TidbNodeGroup{}
+	createNodeGroupResp.UnmarshalJSON([]byte(testUTTidbCloudOpenApidedicatedv1beta1NodeGroup(clusterId, displayName, string(dedicated.DEDICATEDV1BETA1TIDBNODEGROUPSTATE_MODIFYING), 1)))
+	getNodeGroupResp := dedicated.Dedicatedv1beta1TidbNodeGroup{}
+	getNodeGroupResp.UnmarshalJSON([]byte(testUTTidbCloudOpenApidedicatedv1beta1NodeGroup(clusterId, displayName, string(dedicated.DEDICATEDV1BETA1TIDBNODEGROUPSTATE_ACTIVE), 1)))
+	publicEndpointResp := dedicated.V1beta1PublicEndpointSetting{}
+	publicEndpointResp.UnmarshalJSON([]byte(testUTV1beta1PublicEndpointSetting()))
+
+	s.EXPECT().CreateTiDBNodeGroup(gomock.Any(), clusterId, gomock.Any()).Return(&createNodeGroupResp, nil)
+	s.EXPECT().GetTiDBNodeGroup(gomock.Any(), clusterId, nodeGroupId).Return(&getNodeGroupResp, nil).AnyTimes()
+	s.EXPECT().DeleteTiDBNodeGroup(gomock.Any(), clusterId, nodeGroupId).Return(nil)
+	s.EXPECT().GetPublicEndpoint(gomock.Any(), gomock.Any(), gomock.Any()).Return(&publicEndpointResp, nil).AnyTimes()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		PreCheck:   func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUTDedicatedNodeGroupsResourceConfig(),
+			},
+			{
+				ResourceName:    "tidbcloud_dedicated_node_group.test_group",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestUTDedicatedNodeGroupResourceScaling(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudDedicatedClient(ctrl)
+	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudDedicatedClient, error) {
+		return s, nil
+	})()
+
+	clusterId := "cluster_id"
+	nodeGroupId := "node_group_id"
+	nodeCount := 2
+	var updatedNodeCounts []int32
+
+	createNodeGroupResp := dedicated.Dedicatedv1beta1TidbNodeGroup{}
+	createNodeGroupResp.UnmarshalJSON([]byte(testUTTidbCloudOpenApidedicatedv1beta1NodeGroup(clusterId, "test_group", string(dedicated.DEDICATEDV1BETA1TIDBNODEGROUPSTATE_MODIFYING), nodeCount)))
+	publicEndpointResp := dedicated.V1beta1PublicEndpointSetting{}
+	publicEndpointResp.UnmarshalJSON([]byte(testUTV1beta1PublicEndpointSetting()))
+
+	s.EXPECT().CreateTiDBNodeGroup(gomock.Any(), clusterId, gomock.Any()).Return(&createNodeGroupResp, nil)
+	s.EXPECT().GetTiDBNodeGroup(gomock.Any(), clusterId, nodeGroupId).DoAndReturn(
+		func(_ context.Context, _ string, _ string) (*dedicated.Dedicatedv1beta1TidbNodeGroup, error) {
+			nodeGroup := dedicated.Dedicatedv1beta1TidbNodeGroup{}
+			nodeGroup.UnmarshalJSON([]byte(testUTTidbCloudOpenApidedicatedv1beta1NodeGroup(clusterId, "test_group", string(dedicated.DEDICATEDV1BETA1TIDBNODEGROUPSTATE_ACTIVE), nodeCount)))
+			return &nodeGroup, nil
+		}).AnyTimes()
+	s.EXPECT().UpdateTiDBNodeGroup(gomock.Any(), clusterId, nodeGroupId, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, _ string, body *dedicated.TidbNodeGroupServiceUpdateTidbNodeGroupRequest) (*dedicated.Dedicatedv1beta1TidbNodeGroup, error) {
+			nodeCount = int(*body.NodeCount.Get())
+			updatedNodeCounts = append(updatedNodeCounts, *body.NodeCount.Get())
+			return &createNodeGroupResp, nil
+		}).Times(2)
+	s.EXPECT().DeleteTiDBNodeGroup(gomock.Any(), clusterId, gomock.Any()).Return(nil)
+	s.EXPECT().GetPublicEndpoint(gomock.Any(), gomock.Any(), gomock.Any()).Return(&publicEndpointResp, nil).AnyTimes()
+	s.EXPECT().UpdatePublicEndpoint(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&publicEndpointResp, nil).AnyTimes()
+
+	dedicatedNodeGroupResourceName := "tidbcloud_dedicated_node_group.test_group"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUTDedicatedNodeGroupsResourceScalingConfig(2, true),
+			},
+			// scale out one node at a time
+			{
+				Config: testUTDedicatedNodeGroupsResourceScalingConfig(4, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dedicatedNodeGroupResourceName, "node_count", "4"),
+					func(_ *terraform.State) error {
+						if !reflect.DeepEqual(updatedNodeCounts, []int32{3, 4}) {
+							return fmt.Errorf("expected node count to be updated to [3 4], got %v", updatedNodeCounts)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config:      testUTDedicatedNodeGroupsResourceScalingConfig(3, false),
+				ExpectError: regexp.MustCompile(`Scale Down Not Allowed`),
+			},
+			{
+				Config:      testUTDedicatedNodeGroupsResourceScalingConfig(1, true),
+				ExpectError: regexp.MustCompile(`Invalid Node Count`),
+			},
+		},
+	})
+}
+
+func TestUTDedicatedNodeGroupResourceNodeSpec(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudDedicatedClient(ctrl)
+	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudDedicatedClient, error) {
+		return s, nil
+	})()
+
+	clusterId := "cluster_id"
+	nodeGroupId := "node_group_id"
+	clusterNodeSpecKey := "8C16G"
+
+	createNodeGroupResp := dedicated.Dedicatedv1beta1TidbNodeGroup{}
+	createNodeGroupResp.UnmarshalJSON([]byte(testUTTidbCloudOpenApidedicatedv1beta1NodeGroup(clusterId, "test_group", string(dedicated.DEDICATEDV1BETA1TIDBNODEGROUPSTATE_MODIFYING), 1)))
+	publicEndpointResp := dedicated.V1beta1PublicEndpointSetting{}
+	publicEndpointResp.UnmarshalJSON([]byte(testUTV1beta1PublicEndpointSetting()))
+	var nodeSpecs []dedicated.Dedicatedv1beta1NodeSpec
+	if err := json.Unmarshal([]byte(testUTDedicatedv1beta1NodeSpecs), &nodeSpecs); err != nil {
+		t.Fatal(err)
+	}
+
+	s.EXPECT().CreateTiDBNodeGroup(gomock.Any(), clusterId, gomock.Any()).Return(&createNodeGroupResp, nil)
+	s.EXPECT().GetTiDBNodeGroup(gomock.Any(), clusterId, nodeGroupId).DoAndReturn(
+		func(_ context.Context, _ string, _ string) (*dedicated.Dedicatedv1beta1TidbNodeGroup, error) {
+			nodeGroup := dedicated.Dedicatedv1beta1TidbNodeGroup{}
+			nodeGroup.UnmarshalJSON([]byte(testUTTidbCloudOpenApidedicatedv1beta1NodeGroup(clusterId, "test_group", string(dedicated.DEDICATEDV1BETA1TIDBNODEGROUPSTATE_ACTIVE), 1)))
+			nodeSpecKey := clusterNodeSpecKey
+			nodeGroup.NodeSpecKey = &nodeSpecKey
+			return &nodeGroup, nil
+		}).AnyTimes()
+	s.EXPECT().GetCluster(gomock.Any(), clusterId).DoAndReturn(
+		func(_ context.Context, _ string) (*dedicated.TidbCloudOpenApidedicatedv1beta1Cluster, error) {
+			cluster := dedicated.TidbCloudOpenApidedicatedv1beta1Cluster{}
+			cluster.UnmarshalJSON([]byte(testUTTidbCloudOpenApidedicatedv1beta1Cluster(clusterId, "test", string(dedicated.COMMONV1BETA1CLUSTERSTATE_ACTIVE), clusterNodeSpecKey, clusterNodeSpecKey)))
+			return &cluster, nil
+		}).AnyTimes()
+	s.EXPECT().ListNodeSpecs(gomock.Any(), gomock.Any()).Return(nodeSpecs, nil).AnyTimes()
+	s.EXPECT().UpdateTiDBNodeGroup(gomock.Any(), clusterId, nodeGroupId, gomock.Any()).Return(&createNodeGroupResp, nil).AnyTimes()
+	s.EXPECT().DeleteTiDBNodeGroup(gomock.Any(), clusterId, gomock.Any()).Return(nil)
+	s.EXPECT().GetPublicEndpoint(gomock.Any(), gomock.Any(), gomock.Any()).Return(&publicEndpointResp, nil).AnyTimes()
+	s.EXPECT().UpdatePublicEndpoint(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&publicEndpointResp, nil).AnyTimes()
+
+	dedicatedNodeGroupResourceName := "tidbcloud_dedicated_node_group.test_group"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testUTDedicatedNodeGroupsResourceNodeSpecConfig("16C32G"),
+				ExpectError: regexp.MustCompile(`Invalid Node Spec`),
+			},
+			{
+				Config: testUTDedicatedNodeGroupsResourceNodeSpecConfig("8C16G"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dedicatedNodeGroupResourceName, "node_spec_key", "8C16G"),
+				),
+			},
+			// the node spec of the cluster is not changed, so the node group can't change it
+			{
+				Config:      testUTDedicatedNodeGroupsResourceNodeSpecConfig("16C32G"),
+				ExpectError: regexp.MustCompile(`can't be changed on its own`),
+			},
+			// the node spec is changed through the cluster, and the node group follows it in place
+			{
+				PreConfig: func() { clusterNodeSpecKey = "16C32G" },
+				Config:    testUTDedicatedNodeGroupsResourceNodeSpecConfig("16C32G"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dedicatedNodeGroupResourceName, "node_spec_key", "16C32G"),
+				),
+			},
+			{
+				Config:      testUTDedicatedNodeGroupsResourceNodeSpecConfig("4C8G"),
+				ExpectError: regexp.MustCompile(`Invalid Node Spec`),
+			},
+		},
+	})
+}
+
+func TestUTDedicatedNodeGroupResourceTiProxyNodeSpec(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudDedicatedClient(ctrl)
+	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudDedicatedClient, error) {
+		return s, nil
+	})()
+
+	clusterId := "cluster_id"
+	cluster := dedicated.TidbCloudOpenApidedicatedv1beta1Cluster{}
+	cluster.UnmarshalJSON([]byte(testUTTidbCloudOpenApidedicatedv1beta1Cluster(clusterId, "test", string(dedicated.COMMONV1BETA1CLUSTERSTATE_ACTIVE), "8C16G", "8C16G")))
+	var nodeSpecs []dedicated.Dedicatedv1beta1NodeSpec
+	if err := json.Unmarshal([]byte(testUTDedicatedv1beta1NodeSpecs), &nodeSpecs); err != nil {
+		t.Fatal(err)
+	}
+
+	s.EXPECT().GetCluster(gomock.Any(), clusterId).Return(&cluster, nil).AnyTimes()
+	s.EXPECT().ListNodeSpecs(gomock.Any(), gomock.Any()).Return(nodeSpecs, nil).AnyTimes()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// 8C16G is a TiDB node spec, but not a TiProxy one
+			{
+				Config: `
+resource "tidbcloud_dedicated_node_group" "test_group" {
+    cluster_id = "cluster_id"
+    node_count = 1
+    display_name = "test_group"
+    tiproxy_setting = {
+        node_spec_key = "8C16G"
+        node_count = 2
+    }
+}
+`,
+				ExpectError: regexp.MustCompile(`Node spec 8C16G is not available for TiProxy`),
+			},
+		},
+	})
+}
+
+func TestUTDedicatedNodeSpecKeys(t *testing.T) {
+	var nodeSpecs []dedicated.Dedicatedv1beta1NodeSpec
+	if err := json.Unmarshal([]byte(testUTDedicatedv1beta1NodeSpecs), &nodeSpecs); err != nil {
+		t.Fatal(err)
+	}
+	if nodeSpecKeys := dedicatedNodeSpecKeys(nodeSpecs, dedicatedComponentTypeTiDB); !reflect.DeepEqual(nodeSpecKeys, []string{"8C16G", "16C32G"}) {
+		t.Errorf("expected [8C16G 16C32G], got %v", nodeSpecKeys)
+	}
+	if nodeSpecKeys := dedicatedNodeSpecKeys(nodeSpecs, dedicatedComponentTypeTiProxy); !reflect.DeepEqual(nodeSpecKeys, []string{"2C4G"}) {
+		t.Errorf("expected [2C4G], got %v", nodeSpecKeys)
+	}
+	if nodeSpecKeys := dedicatedNodeSpecKeys(nodeSpecs, "TIFLASH"); len(nodeSpecKeys) != 0 {
+		t.Errorf("expected no node specs, got %v", nodeSpecKeys)
+	}
+}
+
+func TestUTDedicatedNodeGroupResourceAdoptDefaultGroup(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudDedicatedClient(ctrl)
+	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudDedicatedClient, error) {
+		return s, nil
+	})()
+
+	clusterId := "cluster_id"
+	nodeGroupId := "node_group_id"
+	isDefaultGroup := true
+
+	defaultGroup := dedicated.Dedicatedv1beta1TidbNodeGroup{}
+	defaultGroup.UnmarshalJSON([]byte(testUTTidbCloudOpenApidedicatedv1beta1NodeGroup(clusterId, "DefaultGroup", string(dedicated.DEDICATEDV1BETA1TIDBNODEGROUPSTATE_ACTIVE), 1)))
+	defaultGroup.IsDefaultGroup = &isDefaultGroup
+	adoptedGroup := dedicated.Dedicatedv1beta1TidbNodeGroup{}
+	adoptedGroup.UnmarshalJSON([]byte(testUTTidbCloudOpenApidedicatedv1beta1NodeGroup(clusterId, "test_group", string(dedicated.DEDICATEDV1BETA1TIDBNODEGROUPSTATE_ACTIVE), 2)))
+	adoptedGroup.IsDefaultGroup = &isDefaultGroup
+	publicEndpointResp := dedicated.V1beta1PublicEndpointSetting{}
+	publicEndpointResp.UnmarshalJSON([]byte(testUTV1beta1PublicEndpointSetting()))
+
+	s.EXPECT().ListTiDBNodeGroups(gomock.Any(), clusterId, gomock.Any(), gomock.Any()).Return(&dedicated.Dedicatedv1beta1ListTidbNodeGroupsResponse{
+		TidbNodeGroups: []dedicated.Dedicatedv1beta1TidbNodeGroup{defaultGroup},
+	}, nil)
+	s.EXPECT().UpdateTiDBNodeGroup(gomock.Any(), clusterId, nodeGroupId, gomock.Any()).Return(&adoptedGroup, nil).Times(1)
+	s.EXPECT().GetTiDBNodeGroup(gomock.Any(), clusterId, nodeGroupId).Return(&adoptedGroup, nil).AnyTimes()
+	s.EXPECT().GetPublicEndpoint(gomock.Any(), gomock.Any(), gomock.Any()).Return(&publicEndpointResp, nil).AnyTimes()
+	s.EXPECT().UpdatePublicEndpoint(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&publicEndpointResp, nil).AnyTimes()
+	// neither created nor deleted
+	s.EXPECT().CreateTiDBNodeGroup(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	s.EXPECT().DeleteTiDBNodeGroup(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	dedicatedNodeGroupResourceName := "tidbcloud_dedicated_node_group.test_group"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUTDedicatedNodeGroupsResourceAdoptDefaultGroupConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dedicatedNodeGroupResourceName, "node_group_id", nodeGroupId),
+					resource.TestCheckResourceAttr(dedicatedNodeGroupResourceName, "is_default_group", "true"),
+					resource.TestCheckResourceAttr(dedicatedNodeGroupResourceName, "node_count", "2"),
+				),
+			},
+			// a node group can't be turned into the default group or back in place
+			{
+				Config:             testUTDedicatedNodeGroupsResourceAdoptDefaultGroupConfig(false),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPreRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(dedicatedNodeGroupResourceName, plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestUTNodeCountSteps(t *testing.T) {
+	tests := []struct {
+		current, target, step int32
+		expected              []int32
+	}{
+		{current: 4, target: 1, expected: []int32{1}},
+		{current: 4, target: 1, step: 1, expected: []int32{3, 2, 1}},
+		{current: 4, target: 1, step: 2, expected: []int32{2, 1}},
+		{current: 1, target: 6, step: 2, expected: []int32{3, 5, 6}},
+		{current: 2, target: 2, step: 1, expected: []int32{2}},
+	}
+	for _, tt := range tests {
+		if steps := nodeCountSteps(tt.current, tt.target, tt.step); !reflect.DeepEqual(steps, tt.expected) {
+			t.Errorf("nodeCountSteps(%d, %d, %d) = %v, expected %v", tt.current, tt.target, tt.step, steps, tt.expected)
+		}
+	}
+}
+
 func testDedicatedNodeGroupResource(t *testing.T) {
 	dedicatedNodeGroupResourceName := "tidbcloud_dedicated_node_group.test_group"
 	resource.Test(t, resource.TestCase{
@@ -113,6 +456,41 @@ resource "tidbcloud_dedicated_node_group" "test_group" {
 `
 }
 
+func testUTDedicatedNodeGroupsResourceScalingConfig(nodeCount int, allowScaleDown bool) string {
+	return fmt.Sprintf(`
+resource "tidbcloud_dedicated_node_group" "test_group" {
+    cluster_id = "cluster_id"
+    node_count = %d
+    display_name = "test_group"
+    min_node_count = 2
+    allow_scale_down = %t
+    scale_step = 1
+}
+`, nodeCount, allowScaleDown)
+}
+
+func testUTDedicatedNodeGroupsResourceNodeSpecConfig(nodeSpecKey string) string {
+	return fmt.Sprintf(`
+resource "tidbcloud_dedicated_node_group" "test_group" {
+    cluster_id = "cluster_id"
+    node_count = 1
+    display_name = "test_group"
+    node_spec_key = "%s"
+}
+`, nodeSpecKey)
+}
+
+func testUTDedicatedNodeGroupsResourceAdoptDefaultGroupConfig(adoptDefaultGroup bool) string {
+	return fmt.Sprintf(`
+resource "tidbcloud_dedicated_node_group" "test_group" {
+    cluster_id = "cluster_id"
+    node_count = 2
+    display_name = "test_group"
+    adopt_default_group = %t
+}
+`, adoptDefaultGroup)
+}
+
 func testUTDedicatedNodeGroupsResourceConfig() string {
 	return `
 resource "tidbcloud_dedicated_node_group" "test_group" {
@@ -214,3 +592,28 @@ func testUTV1beta1PublicEndpointSetting() string {
   ]
 }`
 }
+
+const testUTDedicatedv1beta1NodeSpecs = `
+[
+    {
+        "componentType": "TIDB",
+        "nodeSpecKey": "8C16G",
+        "displayName": "8 vCPU, 16 GiB"
+    },
+    {
+        "componentType": "TIDB",
+        "nodeSpecKey": "16C32G",
+        "displayName": "16 vCPU, 32 GiB"
+    },
+    {
+        "componentType": "TIKV",
+        "nodeSpecKey": "8C32G",
+        "displayName": "8 vCPU, 32 GiB"
+    },
+    {
+        "componentType": "TIPROXY",
+        "nodeSpecKey": "2C4G",
+        "displayName": "2 vCPU, 4 GiB"
+    }
+]
+`
@@ -106,6 +106,10 @@ func (d *dedicatedNodeGroupsDataSource) Schema(_ context.Context, _ datasource.S
 							MarkdownDescription: "Settings for TiProxy nodes.",
 							Computed:            true,
 							Attributes: map[string]schema.Attribute{
+								"enabled": schema.BoolAttribute{
+									MarkdownDescription: "Whether TiProxy is enabled.",
+									Computed:            true,
+								},
 								"node_spec_key": schema.StringAttribute{
 									MarkdownDescription: "The key of the node spec.",
 									Computed:            true,
@@ -185,13 +189,8 @@ func (d *dedicatedNodeGroupsDataSource) Read(ctx context.Context, req datasource
 		diags.Append(listDiags...)
 
 		tiProxy := tiProxySetting{}
-		if nodeGroup.TiproxySetting != nil {
-			tiProxy = tiProxySetting{
-				NodeSpecKey:         types.StringValue(nodeGroup.TiproxySetting.NodeSpecKey),
-				NodeSpecVersion:     types.StringValue(*nodeGroup.TiproxySetting.NodeSpecVersion),
-				NodeCount:           types.Int32Value(*nodeGroup.TiproxySetting.NodeCount.Get()),
-				NodeSpecDisplayName: types.StringValue(*nodeGroup.TiproxySetting.NodeSpecDisplayName),
-			}
+		if s := convertTiProxySetting(nodeGroup.TiproxySetting); s != nil {
+			tiProxy = *s
 		}
 		publicEndpointSetting, err := d.provider.DedicatedClient.GetPublicEndpoint(ctx, data.ClusterId.ValueString(), *nodeGroup.TidbNodeGroupId)
 		if err != nil {
@@ -2,15 +2,19 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strings"
+	"sync"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -42,6 +46,49 @@ type dedicatedPrivateEndpointConnectionResourceData struct {
 	AccountId                   types.String `tfsdk:"account_id"`
 	Host                        types.String `tfsdk:"host"`
 	Port                        types.Int32  `tfsdk:"port"`
+	AWS                         types.Object `tfsdk:"aws"`
+	GCP                         types.Object `tfsdk:"gcp"`
+	Azure                       types.Object `tfsdk:"azure"`
+}
+
+type privateEndpointConnectionAWS struct {
+	VpcEndpointId types.String `tfsdk:"vpc_endpoint_id"`
+	ServiceName   types.String `tfsdk:"service_name"`
+}
+
+var privateEndpointConnectionAWSAttrTypes = map[string]attr.Type{
+	"vpc_endpoint_id": types.StringType,
+	"service_name":    types.StringType,
+}
+
+type privateEndpointConnectionGCP struct {
+	PscEndpointId     types.String `tfsdk:"psc_endpoint_id"`
+	ProjectId         types.String `tfsdk:"project_id"`
+	ServiceAttachment types.String `tfsdk:"service_attachment"`
+}
+
+var privateEndpointConnectionGCPAttrTypes = map[string]attr.Type{
+	"psc_endpoint_id":    types.StringType,
+	"project_id":         types.StringType,
+	"service_attachment": types.StringType,
+}
+
+type privateEndpointConnectionAzure struct {
+	PrivateEndpointId       types.String `tfsdk:"private_endpoint_id"`
+	PrivateIpAddress        types.String `tfsdk:"private_ip_address"`
+	PrivateLinkServiceAlias types.String `tfsdk:"private_link_service_alias"`
+}
+
+var privateEndpointConnectionAzureAttrTypes = map[string]attr.Type{
+	"private_endpoint_id":        types.StringType,
+	"private_ip_address":         types.StringType,
+	"private_link_service_alias": types.StringType,
+}
+
+type dedicatedPrivateEndpointConnectionResourceIdentity struct {
+	ClusterId                   types.String `tfsdk:"cluster_id"`
+	NodeGroupId                 types.String `tfsdk:"node_group_id"`
+	PrivateEndpointConnectionId types.String `tfsdk:"private_endpoint_connection_id"`
 }
 
 type dedicatedPrivateEndpointConnectionResource struct {
@@ -113,6 +160,9 @@ func (r *dedicatedPrivateEndpointConnectionResource) Schema(_ context.Context, _
 					"TiDB Cloud will setup a public DNS record for this private IP address. So the user can use DNS address to connect to the cluster." +
 					"Only available for Azure clusters.",
 				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"endpoint_state": schema.StringAttribute{
 				MarkdownDescription: "The state of the endpoint.",
@@ -176,6 +226,84 @@ func (r *dedicatedPrivateEndpointConnectionResource) Schema(_ context.Context, _
 				Computed:            true,
 				ElementType:         types.StringType,
 			},
+			"aws": schema.SingleNestedAttribute{
+				MarkdownDescription: "The AWS PrivateLink connection. Only for AWS clusters.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"vpc_endpoint_id": schema.StringAttribute{
+						MarkdownDescription: "The ID of the VPC endpoint.",
+						Computed:            true,
+					},
+					"service_name": schema.StringAttribute{
+						MarkdownDescription: "The name of the endpoint service that the VPC endpoint connects to.",
+						Computed:            true,
+					},
+				},
+			},
+			"gcp": schema.SingleNestedAttribute{
+				MarkdownDescription: "The GCP Private Service Connect connection. Only for GCP clusters.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"psc_endpoint_id": schema.StringAttribute{
+						MarkdownDescription: "The ID of the Private Service Connect endpoint.",
+						Computed:            true,
+					},
+					"project_id": schema.StringAttribute{
+						MarkdownDescription: "The GCP project of the Private Service Connect endpoint.",
+						Computed:            true,
+					},
+					"service_attachment": schema.StringAttribute{
+						MarkdownDescription: "The service attachment that the Private Service Connect endpoint connects to.",
+						Computed:            true,
+					},
+				},
+			},
+			"azure": schema.SingleNestedAttribute{
+				MarkdownDescription: "The Azure Private Link connection. Only for Azure clusters.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"private_endpoint_id": schema.StringAttribute{
+						MarkdownDescription: "The resource ID of the private endpoint.",
+						Computed:            true,
+					},
+					"private_ip_address": schema.StringAttribute{
+						MarkdownDescription: "The private IP address of the private endpoint.",
+						Computed:            true,
+					},
+					"private_link_service_alias": schema.StringAttribute{
+						MarkdownDescription: "The alias of the private link service that the private endpoint connects to.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *dedicatedPrivateEndpointConnectionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"cluster_id": identityschema.StringAttribute{
+				Description:       "The ID of the cluster.",
+				RequiredForImport: true,
+			},
+			"node_group_id": identityschema.StringAttribute{
+				Description:       "The ID of the TiDB node group.",
+				RequiredForImport: true,
+			},
+			"private_endpoint_connection_id": identityschema.StringAttribute{
+				Description:       "The ID of the private endpoint connection.",
+				RequiredForImport: true,
+			},
 		},
 	}
 }
@@ -207,8 +335,25 @@ func (r dedicatedPrivateEndpointConnectionResource) Create(ctx context.Context,
 	privateEndpointConnectionId := *privateEndpointConnection.PrivateEndpointConnectionId
 	data.PrivateEndpointConnectionId = types.StringValue(privateEndpointConnectionId)
 	tflog.Info(ctx, "wait dedicated private endpoint connection ready")
-	privateEndpointConnection, err = WaitDedicatedPrivateEndpointConnectionReady(ctx, dedicatedPrivateEndpointConnectionCreateTimeout, dedicatedPrivateEndpointConnectionCreateInterval, data.ClusterId.ValueString(), data.NodeGroupId.ValueString(), privateEndpointConnectionId, r.provider.DedicatedClient)
+	transitions := &endpointStateTransitions{}
+	privateEndpointConnection, err = WaitDedicatedPrivateEndpointConnectionReady(ctx, dedicatedPrivateEndpointConnectionCreateTimeout, dedicatedPrivateEndpointConnectionCreateInterval, data.ClusterId.ValueString(), data.NodeGroupId.ValueString(), privateEndpointConnectionId, transitions, r.provider.DedicatedClient)
+	resp.Diagnostics.Append(transitions.warnings()...)
 	if err != nil {
+		var failed *privateEndpointConnectionFailedError
+		if errors.As(err, &failed) {
+			// Save the rejected connection, so that it's tainted and deleted by the next apply instead of being left behind.
+			data.EndpointState = types.StringValue(failed.state)
+			if privateEndpointConnection != nil {
+				resp.Diagnostics.Append(refreshDedicatedPrivateEndpointConnectionResourceData(ctx, privateEndpointConnection, &data)...)
+			}
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(resp.Identity.Set(ctx, dedicatedPrivateEndpointConnectionResourceIdentity{ClusterId: data.ClusterId, NodeGroupId: data.NodeGroupId, PrivateEndpointConnectionId: data.PrivateEndpointConnectionId})...)
+			resp.Diagnostics.AddError(
+				"Dedicated private endpoint connection rejected",
+				fmt.Sprintf("Private endpoint connection %s for endpoint %s is %s: %s", privateEndpointConnectionId, data.EndpointId.ValueString(), failed.state, failed.message),
+			)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Dedicated private endpoint connection creation failed",
 			fmt.Sprintf("Dedicated private endpoint connection is not ready, get error: %s", err),
@@ -216,11 +361,15 @@ func (r dedicatedPrivateEndpointConnectionResource) Create(ctx context.Context,
 		return
 	}
 
-	refreshDedicatedPrivateEndpointConnectionResourceData(ctx, privateEndpointConnection, &data)
+	resp.Diagnostics.Append(refreshDedicatedPrivateEndpointConnectionResourceData(ctx, privateEndpointConnection, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// save into the Terraform state.
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, dedicatedPrivateEndpointConnectionResourceIdentity{ClusterId: data.ClusterId, NodeGroupId: data.NodeGroupId, PrivateEndpointConnectionId: data.PrivateEndpointConnectionId})...)
 }
 
 func (r dedicatedPrivateEndpointConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -240,11 +389,15 @@ func (r dedicatedPrivateEndpointConnectionResource) Read(ctx context.Context, re
 		tflog.Error(ctx, fmt.Sprintf("Unable to call GetPrivateEndpointConnection, error: %s", err))
 		return
 	}
-	refreshDedicatedPrivateEndpointConnectionResourceData(ctx, privateEndpointConnection, &data)
+	resp.Diagnostics.Append(refreshDedicatedPrivateEndpointConnectionResourceData(ctx, privateEndpointConnection, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// save into the Terraform state.
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, dedicatedPrivateEndpointConnectionResourceIdentity{ClusterId: data.ClusterId, NodeGroupId: data.NodeGroupId, PrivateEndpointConnectionId: data.PrivateEndpointConnectionId})...)
 }
 
 func (r dedicatedPrivateEndpointConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -267,25 +420,14 @@ func (r dedicatedPrivateEndpointConnectionResource) Delete(ctx context.Context,
 	}
 }
 
-// NOTICE: update is not supported for dedicated private endpoint connection
+// NOTICE: private endpoint connections can't be updated through the API, so all the configurable attributes require
+// replacement and Update is never called with changes.
 func (r dedicatedPrivateEndpointConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	resp.Diagnostics.AddError("Update Error", "Update is not supported for dedicated private endpoint connection")
 }
 
 func (r dedicatedPrivateEndpointConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: cluster_id, node_group_id, private_endpoint_connection_id. Got: %q", req.ID),
-		)
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("node_group_id"), idParts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("private_endpoint_connection_id"), idParts[2])...)
+	importStateByFields(ctx, "tidbcloud_dedicated_private_endpoint_connection", req, resp)
 }
 
 func buildCreateDedicatedPrivateEndpointConnectionBody(data dedicatedPrivateEndpointConnectionResourceData) dedicated.PrivateEndpointConnectionServiceCreatePrivateEndpointConnectionRequest {
@@ -321,25 +463,87 @@ func refreshDedicatedPrivateEndpointConnectionResourceData(ctx context.Context,
 	data.Host = types.StringValue(*resp.Host)
 	data.Port = types.Int32Value(*resp.Port)
 
-	return nil
+	data.AWS = types.ObjectNull(privateEndpointConnectionAWSAttrTypes)
+	data.GCP = types.ObjectNull(privateEndpointConnectionGCPAttrTypes)
+	data.Azure = types.ObjectNull(privateEndpointConnectionAzureAttrTypes)
+	switch string(*resp.CloudProvider) {
+	case "aws":
+		data.AWS, diags = types.ObjectValueFrom(ctx, privateEndpointConnectionAWSAttrTypes, privateEndpointConnectionAWS{
+			VpcEndpointId: data.EndpointId,
+			ServiceName:   data.PrivateLinkServiceName,
+		})
+	case "gcp":
+		data.GCP, diags = types.ObjectValueFrom(ctx, privateEndpointConnectionGCPAttrTypes, privateEndpointConnectionGCP{
+			PscEndpointId:     data.EndpointId,
+			ProjectId:         data.AccountId,
+			ServiceAttachment: data.PrivateLinkServiceName,
+		})
+	case "azure":
+		data.Azure, diags = types.ObjectValueFrom(ctx, privateEndpointConnectionAzureAttrTypes, privateEndpointConnectionAzure{
+			PrivateEndpointId:       data.EndpointId,
+			PrivateIpAddress:        data.PrivateIpAddress,
+			PrivateLinkServiceAlias: data.PrivateLinkServiceName,
+		})
+	}
+	return diags
 }
 
+// privateEndpointConnectionFailedError is returned when the private endpoint connection is rejected while waiting.
+type privateEndpointConnectionFailedError struct {
+	state   string
+	message string
+}
+
+func (e *privateEndpointConnectionFailedError) Error() string {
+	return fmt.Sprintf("private endpoint connection is %s: %s", e.state, e.message)
+}
+
+// endpointStateTransitions records the endpoint states seen while waiting, so that they can be surfaced as warnings.
+// The states are recorded in the goroutine of the waiter, hence the lock.
+type endpointStateTransitions struct {
+	mu     sync.Mutex
+	states []string
+}
+
+func (t *endpointStateTransitions) record(state string) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.states) == 0 || t.states[len(t.states)-1] != state {
+		t.states = append(t.states, state)
+	}
+}
+
+func (t *endpointStateTransitions) warnings() diag.Diagnostics {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var diags diag.Diagnostics
+	for i := 1; i < len(t.states); i++ {
+		diags.AddWarning("Private Endpoint Connection State Changed",
+			fmt.Sprintf("endpoint_state changed from %s to %s while waiting for the private endpoint connection", t.states[i-1], t.states[i]))
+	}
+	return diags
+}
+
+// WaitDedicatedPrivateEndpointConnectionReady waits until the private endpoint connection is ACTIVE or DISCOVERED.
+// A FAILED or DELETING connection returns a *privateEndpointConnectionFailedError with the message of the connection.
+// transitions is optional.
 func WaitDedicatedPrivateEndpointConnectionReady(ctx context.Context, timeout time.Duration, interval time.Duration, clusterId string, nodeGroupId string, privateEndpointConnectionId string,
-	client tidbcloud.TiDBCloudDedicatedClient) (*dedicated.Dedicatedv1beta1PrivateEndpointConnection, error) {
+	transitions *endpointStateTransitions, client tidbcloud.TiDBCloudDedicatedClient) (*dedicated.Dedicatedv1beta1PrivateEndpointConnection, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
 			string(dedicated.DEDICATEDV1BETA1PRIVATEENDPOINTCONNECTIONENDPOINTSTATE_PENDING),
 		},
 		Target: []string{
 			string(dedicated.DEDICATEDV1BETA1PRIVATEENDPOINTCONNECTIONENDPOINTSTATE_ACTIVE),
-			string(dedicated.DEDICATEDV1BETA1PRIVATEENDPOINTCONNECTIONENDPOINTSTATE_DELETING),
-			string(dedicated.DEDICATEDV1BETA1PRIVATEENDPOINTCONNECTIONENDPOINTSTATE_FAILED),
 			string(dedicated.DEDICATEDV1BETA1PRIVATEENDPOINTCONNECTIONENDPOINTSTATE_DISCOVERED),
 		},
 		Timeout:      timeout,
 		MinTimeout:   500 * time.Millisecond,
 		PollInterval: interval,
-		Refresh:      dedicatedPrivateEndpointConnectionStateRefreshFunc(ctx, clusterId, nodeGroupId, privateEndpointConnectionId, client),
+		Refresh:      dedicatedPrivateEndpointConnectionStateRefreshFunc(ctx, clusterId, nodeGroupId, privateEndpointConnectionId, transitions, client),
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -351,14 +555,24 @@ func WaitDedicatedPrivateEndpointConnectionReady(ctx context.Context, timeout ti
 }
 
 func dedicatedPrivateEndpointConnectionStateRefreshFunc(ctx context.Context, clusterId string, nodeGroupId string, privateEndpointConnectionId string,
-	client tidbcloud.TiDBCloudDedicatedClient) retry.StateRefreshFunc {
+	transitions *endpointStateTransitions, client tidbcloud.TiDBCloudDedicatedClient) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		tflog.Trace(ctx, "Waiting for dedicated private endpoint connection ready")
 		privateEndpointConnection, err := client.GetPrivateEndpointConnection(ctx, clusterId, nodeGroupId, privateEndpointConnectionId)
 		if err != nil {
 			return nil, "", err
 		}
-		tflog.Debug(ctx, fmt.Sprintf("dedicated private endpoint connection state: %s", *privateEndpointConnection.EndpointState))
-		return privateEndpointConnection, string(*privateEndpointConnection.EndpointState), nil
+		state := *privateEndpointConnection.EndpointState
+		tflog.Debug(ctx, fmt.Sprintf("dedicated private endpoint connection state: %s", state))
+		transitions.record(string(state))
+		if state == dedicated.DEDICATEDV1BETA1PRIVATEENDPOINTCONNECTIONENDPOINTSTATE_FAILED ||
+			state == dedicated.DEDICATEDV1BETA1PRIVATEENDPOINTCONNECTIONENDPOINTSTATE_DELETING {
+			message := ""
+			if privateEndpointConnection.Message != nil {
+				message = *privateEndpointConnection.Message
+			}
+			return privateEndpointConnection, string(state), &privateEndpointConnectionFailedError{state: string(state), message: message}
+		}
+		return privateEndpointConnection, string(state), nil
 	}
 }
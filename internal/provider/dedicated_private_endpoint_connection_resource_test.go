@@ -2,10 +2,13 @@ package provider
 
 import (
 	"fmt"
+	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	mockClient "github.com/tidbcloud/terraform-provider-tidbcloud/mock"
 	"github.com/tidbcloud/terraform-provider-tidbcloud/tidbcloud"
 	"github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/dedicated"
@@ -16,7 +19,7 @@ func TestUTDedicatedPrivateEndpointConnectionResource(t *testing.T) {
 
 	ctrl := gomock.NewController(t)
 	s := mockClient.NewMockTiDBCloudDedicatedClient(ctrl)
-	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string) (tidbcloud.TiDBCloudDedicatedClient, error) {
+	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudDedicatedClient, error) {
 		return s, nil
 	})()
 
@@ -32,6 +35,76 @@ func TestUTDedicatedPrivateEndpointConnectionResource(t *testing.T) {
 	testDedicatedPrivateEndpointConnectionResource(t)
 }
 
+func TestUTDedicatedPrivateEndpointConnectionResourceRejected(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudDedicatedClient(ctrl)
+	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudDedicatedClient, error) {
+		return s, nil
+	})()
+
+	createPrivateEndpointConnectionResp := dedicated.Dedicatedv1beta1PrivateEndpointConnection{}
+	createPrivateEndpointConnectionResp.UnmarshalJSON([]byte(testUTPrivateEndpointConnection(string(dedicated.DEDICATEDV1BETA1PRIVATEENDPOINTCONNECTIONENDPOINTSTATE_PENDING))))
+	getPrivateEndpointConnectionResp := dedicated.Dedicatedv1beta1PrivateEndpointConnection{}
+	getPrivateEndpointConnectionResp.UnmarshalJSON([]byte(testUTPrivateEndpointConnection(string(dedicated.DEDICATEDV1BETA1PRIVATEENDPOINTCONNECTIONENDPOINTSTATE_FAILED))))
+	message := "the endpoint is not in the allowed principals"
+	getPrivateEndpointConnectionResp.Message = &message
+
+	s.EXPECT().CreatePrivateEndpointConnection(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&createPrivateEndpointConnectionResp, nil)
+	s.EXPECT().GetPrivateEndpointConnection(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&getPrivateEndpointConnectionResp, nil).AnyTimes()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testUTDedicatedPrivateEndpointConnectionResourceConfig(),
+				ExpectError: regexp.MustCompile(`Dedicated private endpoint connection rejected`),
+			},
+		},
+	})
+}
+
+func TestUTDedicatedPrivateEndpointConnectionResourceImportByIdentity(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudDedicatedClient(ctrl)
+	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudDedicatedClient, error) {
+		return s, nil
+	})()
+
+	createPrivateEndpointConnectionResp := dedicated.Dedicatedv1beta1PrivateEndpointConnection{}
+	createPrivateEndpointConnectionResp.UnmarshalJSON([]byte(testUTPrivateEndpointConnection(string(dedicated.DEDICATEDV1BETA1PRIVATEENDPOINTCONNECTIONENDPOINTSTATE_PENDING))))
+	getPrivateEndpointConnectionResp := dedicated.Dedicatedv1beta1PrivateEndpointConnection{}
+	getPrivateEndpointConnectionResp.UnmarshalJSON([]byte(testUTPrivateEndpointConnection(string(dedicated.DEDICATEDV1BETA1PRIVATEENDPOINTCONNECTIONENDPOINTSTATE_ACTIVE))))
+
+	s.EXPECT().CreatePrivateEndpointConnection(gomock.Any(), "clusterId", "nodeGroupId", gomock.Any()).Return(&createPrivateEndpointConnectionResp, nil)
+	s.EXPECT().GetPrivateEndpointConnection(gomock.Any(), "clusterId", "nodeGroupId", "id").Return(&getPrivateEndpointConnectionResp, nil).AnyTimes()
+	s.EXPECT().DeletePrivateEndpointConnection(gomock.Any(), "clusterId", "nodeGroupId", "id").Return(nil)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		PreCheck:   func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUTDedicatedPrivateEndpointConnectionResourceConfig(),
+			},
+			{
+				ResourceName:    "tidbcloud_dedicated_private_endpoint_connection.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
 func testDedicatedPrivateEndpointConnectionResource(t *testing.T) {
 	dedicatedPrivateEndpointConnectionResourceName := "tidbcloud_dedicated_private_endpoint_connection.test"
 	resource.Test(t, resource.TestCase{
@@ -44,6 +117,8 @@ func testDedicatedPrivateEndpointConnectionResource(t *testing.T) {
 				Config: testUTDedicatedPrivateEndpointConnectionResourceConfig(),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(dedicatedPrivateEndpointConnectionResourceName, "region_id", "aws-us-west-2"),
+					resource.TestCheckResourceAttr(dedicatedPrivateEndpointConnectionResourceName, "aws.service_name", "com.amazonaws.vpce.us-west-2.vpce-svc-0e8a2cd00000"),
+					resource.TestCheckNoResourceAttr(dedicatedPrivateEndpointConnectionResourceName, "gcp"),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
@@ -70,7 +70,7 @@ func (d *dedicatedRegionDataSource) Read(ctx context.Context, req datasource.Rea
 	}
 
 	tflog.Trace(ctx, "read region data source")
-	region, err := d.provider.DedicatedClient.GetRegion(ctx, data.RegionId.ValueString())
+	region, err := d.provider.getDedicatedRegion(ctx, data.RegionId.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to call GetRegion, got error: %s", err))
 		return
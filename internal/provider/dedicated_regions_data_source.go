@@ -86,7 +86,7 @@ func (d *dedicatedRegionsDataSource) Read(ctx context.Context, req datasource.Re
 	}
 
 	tflog.Trace(ctx, "read regions data source")
-	regions, err := d.provider.DedicatedClient.ListRegions(ctx, data.CloudProvider.ValueString(), data.ProjectId.ValueString())
+	regions, err := d.provider.listDedicatedRegions(ctx, data.CloudProvider.ValueString(), data.ProjectId.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to call ListRegions, got error: %s", err))
 		return
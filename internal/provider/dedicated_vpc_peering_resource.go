@@ -5,11 +5,15 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -17,8 +21,18 @@ import (
 	"github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/dedicated"
 )
 
+const (
+	dedicatedVpcPeeringCreateTimeout  = 10 * time.Minute
+	dedicatedVpcPeeringCreateInterval = 10 * time.Second
+	dedicatedVpcPeeringActiveTimeout  = time.Hour
+	dedicatedVpcPeeringActiveInterval = 30 * time.Second
+	customerVpcMinCidrPrefix          = 8
+	customerVpcMaxCidrPrefix          = 28
+)
+
 var (
-	_ resource.Resource = &DedicatedVpcPeeringResource{}
+	_ resource.Resource               = &DedicatedVpcPeeringResource{}
+	_ resource.ResourceWithModifyPlan = &DedicatedVpcPeeringResource{}
 )
 
 type DedicatedVpcPeeringResource struct {
@@ -39,7 +53,32 @@ type DedicatedVpcPeeringResourceData struct {
 	CustomerVpcCidr           types.String `tfsdk:"customer_vpc_cidr"`
 	State                     types.String `tfsdk:"state"`
 	AWSVpcPeeringConnectionId types.String `tfsdk:"aws_vpc_peering_connection_id"`
+	GCP                       types.Object `tfsdk:"gcp"`
+	Azure                     types.Object `tfsdk:"azure"`
 	Labels                    types.Map    `tfsdk:"labels"`
+	WaitForActive             types.Bool   `tfsdk:"wait_for_active"`
+}
+
+type vpcPeeringGCP struct {
+	PeerProjectId   types.String `tfsdk:"peer_project_id"`
+	PeerNetworkName types.String `tfsdk:"peer_network_name"`
+	PeerNetwork     types.String `tfsdk:"peer_network"`
+}
+
+var vpcPeeringGCPAttrTypes = map[string]attr.Type{
+	"peer_project_id":   types.StringType,
+	"peer_network_name": types.StringType,
+	"peer_network":      types.StringType,
+}
+
+type vpcPeeringAzure struct {
+	PeerSubscriptionId   types.String `tfsdk:"peer_subscription_id"`
+	PeerVirtualNetworkId types.String `tfsdk:"peer_virtual_network_id"`
+}
+
+var vpcPeeringAzureAttrTypes = map[string]attr.Type{
+	"peer_subscription_id":    types.StringType,
+	"peer_virtual_network_id": types.StringType,
 }
 
 func NewDedicatedVpcPeeringResource() resource.Resource {
@@ -73,6 +112,9 @@ func (r *DedicatedVpcPeeringResource) Schema(_ context.Context, _ resource.Schem
 			"tidb_cloud_region_id": schema.StringAttribute{
 				Description: "The region ID of the TiDB Cloud",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"tidb_cloud_cloud_provider": schema.StringAttribute{
 				Description: "The cloud provider of the TiDB Cloud",
@@ -103,37 +145,94 @@ func (r *DedicatedVpcPeeringResource) Schema(_ context.Context, _ resource.Schem
 				},
 			},
 			"customer_region_id": schema.StringAttribute{
-				Description: "The region ID of the AWS VPC",
+				Description: "The region ID of the customer VPC",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"customer_account_id": schema.StringAttribute{
-				Description: "The account ID of the AWS VPC",
+				Description: "The account ID of the customer VPC. For AWS, it's the AWS account ID. For GCP, it's the project ID. For Azure, it's the subscription ID",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"customer_vpc_id": schema.StringAttribute{
-				Description: "The ID of the AWS VPC",
+				Description: "The ID of the customer VPC. For GCP, it's the VPC network name. For Azure, it's the resource ID of the virtual network",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"customer_vpc_cidr": schema.StringAttribute{
-				Description: "The VPC CIDR of the AWS VPC",
+				Description: "The VPC CIDR of the customer VPC. It must have a prefix length between /8 and /28, and must not overlap the CIDR of the TiDB Cloud VPC",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					cidrValidator{minPrefix: customerVpcMinCidrPrefix, maxPrefix: customerVpcMaxCidrPrefix},
+				},
 			},
 			"state": schema.StringAttribute{
 				Description: "The state of the VPC Peering",
 				Computed:    true,
 			},
 			"aws_vpc_peering_connection_id": schema.StringAttribute{
-				Description: "The ID of the AWS VPC Peering Connection",
+				Description: "The ID of the AWS VPC Peering Connection. Only for AWS, and it needs to be accepted in the customer account",
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"gcp": schema.SingleNestedAttribute{
+				Description: "The TiDB Cloud network to peer with from the customer VPC network. Only for GCP. The API does not return a peering name, the google_compute_network_peering in the customer project can be named freely",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"peer_project_id": schema.StringAttribute{
+						Description: "The project ID of the TiDB Cloud VPC network",
+						Computed:    true,
+					},
+					"peer_network_name": schema.StringAttribute{
+						Description: "The name of the TiDB Cloud VPC network",
+						Computed:    true,
+					},
+					"peer_network": schema.StringAttribute{
+						Description: "The TiDB Cloud VPC network in the projects/{project}/global/networks/{network} format, which can be used as peer_network of google_compute_network_peering",
+						Computed:    true,
+					},
+				},
+			},
+			"azure": schema.SingleNestedAttribute{
+				Description: "The TiDB Cloud virtual network to peer with from the customer virtual network. Only for Azure. The API does not return a peering name, the azurerm_virtual_network_peering in the customer subscription can be named freely",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"peer_subscription_id": schema.StringAttribute{
+						Description: "The subscription ID of the TiDB Cloud virtual network",
+						Computed:    true,
+					},
+					"peer_virtual_network_id": schema.StringAttribute{
+						Description: "The ID of the TiDB Cloud virtual network, which can be used as remote_virtual_network_id of azurerm_virtual_network_peering",
+						Computed:    true,
+					},
+				},
+			},
 			"labels": schema.MapAttribute{
 				Description: "The labels for the vpc peering",
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"wait_for_active": schema.BoolAttribute{
+				Description: "Whether to wait on creation until the customer side accepts the VPC Peering and it becomes ACTIVE. Default is false, which only waits until the VPC Peering is ready to be accepted",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -152,6 +251,66 @@ func (r *DedicatedVpcPeeringResource) Configure(ctx context.Context, req resourc
 	}
 }
 
+// ModifyPlan checks on creation that the customer VPC CIDR doesn't overlap the TiDB Cloud VPC CIDR of the network
+// container in the region, nor the customer VPC CIDRs of the other VPC peerings in the region.
+func (r *DedicatedVpcPeeringResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() || r.provider == nil || !r.provider.configured {
+		return
+	}
+	var plan DedicatedVpcPeeringResourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !IsKnown(plan.TiDBCloudRegionId) || !IsKnown(plan.CustomerVpcCidr) {
+		return
+	}
+	regionId := plan.TiDBCloudRegionId.ValueString()
+	customerVpcCidr := plan.CustomerVpcCidr.ValueString()
+	if validateCIDR(customerVpcCidr, customerVpcMinCidrPrefix, customerVpcMaxCidrPrefix) != nil {
+		// reported by the validator
+		return
+	}
+	projectId := ""
+	if IsKnown(plan.ProjectId) {
+		projectId = plan.ProjectId.ValueString()
+	}
+
+	networkContainers, err := dedicatedNetworkContainersDataSource{provider: r.provider}.retrieveNetworkContainers(ctx, projectId)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to check CIDR overlaps", fmt.Sprintf("Unable to call ListNetworkContainers, got error: %s", err))
+		return
+	}
+	for _, networkContainer := range networkContainers {
+		if networkContainer.RegionId != regionId || networkContainer.CidrNotation == nil {
+			continue
+		}
+		if overlap, _ := cidrsOverlap(customerVpcCidr, *networkContainer.CidrNotation); overlap {
+			resp.Diagnostics.AddAttributeError(path.Root("customer_vpc_cidr"), "Overlapping CIDR Blocks",
+				fmt.Sprintf("The customer VPC CIDR %s overlaps the TiDB Cloud VPC CIDR %s of network container %s in %s. The VPC peering can't route between overlapping CIDR blocks.",
+					customerVpcCidr, *networkContainer.CidrNotation, *networkContainer.NetworkContainerId, regionId))
+			return
+		}
+	}
+
+	cloudProvider, _, _ := parseRegionName(regionId)
+	vpcPeerings, err := dedicatedVpcPeeringsDataSource{provider: r.provider}.retrieveVPCPeerings(ctx, projectId, cloudProvider)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to check CIDR overlaps", fmt.Sprintf("Unable to call ListVPCPeerings, got error: %s", err))
+		return
+	}
+	for _, vpcPeering := range vpcPeerings {
+		if vpcPeering.TidbCloudRegionId != regionId {
+			continue
+		}
+		if overlap, _ := cidrsOverlap(customerVpcCidr, vpcPeering.CustomerVpcCidr); overlap {
+			resp.Diagnostics.AddAttributeWarning(path.Root("customer_vpc_cidr"), "Overlapping CIDR Blocks",
+				fmt.Sprintf("The customer VPC CIDR %s overlaps the customer VPC CIDR %s of VPC peering %s in %s. The TiDB Cloud VPC can't route to both of them.",
+					customerVpcCidr, vpcPeering.CustomerVpcCidr, *vpcPeering.VpcPeeringId, regionId))
+		}
+	}
+}
+
 func (r *DedicatedVpcPeeringResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if !r.provider.configured {
 		resp.Diagnostics.AddError(
@@ -176,22 +335,63 @@ func (r *DedicatedVpcPeeringResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
-	VpcPeering, err = WaitDedicatedVpcPeeringReady(ctx, clusterCreateTimeout, clusterCreateInterval, *VpcPeering.VpcPeeringId, r.provider.DedicatedClient)
+	vpcPeeringId := *VpcPeering.VpcPeeringId
+	createdVpcPeering := VpcPeering
+	data.VpcPeeringId = types.StringValue(vpcPeeringId)
+	VpcPeering, err = WaitDedicatedVpcPeeringReady(ctx, dedicatedVpcPeeringCreateTimeout, dedicatedVpcPeeringCreateInterval, vpcPeeringId, false, r.provider.DedicatedClient)
 	if err != nil {
+		r.saveUnreadyVpcPeering(ctx, createdVpcPeering, VpcPeering, &data, resp)
 		resp.Diagnostics.AddError(
 			"Dedicated vpc peering creation failed",
-			fmt.Sprintf("aws_vpc_peering_connection_id is not ready, get error: %s", err),
+			fmt.Sprintf("dedicated vpc peering %s is not ready, get error: %s", vpcPeeringId, err),
 		)
 		return
 	}
+	if data.WaitForActive.ValueBool() {
+		tflog.Info(ctx, fmt.Sprintf("waiting for dedicated vpc peering %s to be accepted", vpcPeeringId))
+		readyVpcPeering := VpcPeering
+		VpcPeering, err = WaitDedicatedVpcPeeringReady(ctx, dedicatedVpcPeeringActiveTimeout, dedicatedVpcPeeringActiveInterval, vpcPeeringId, true, r.provider.DedicatedClient)
+		if err != nil {
+			r.saveUnreadyVpcPeering(ctx, readyVpcPeering, VpcPeering, &data, resp)
+			resp.Diagnostics.AddError(
+				"Dedicated vpc peering creation failed",
+				fmt.Sprintf("dedicated vpc peering %s is not active, make sure it is accepted in the customer VPC, get error: %s", vpcPeeringId, err),
+			)
+			return
+		}
+	}
 
-	refreshDedicatedVpcPeeringResourceData(ctx, VpcPeering, &data)
+	resp.Diagnostics.Append(refreshDedicatedVpcPeeringResourceData(ctx, VpcPeering, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// save into the Terraform state.
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
 
+// saveUnreadyVpcPeering saves a vpc peering which didn't get ready into the state, so that it's tainted and
+// deleted by the next apply instead of being left behind. The wait returns no vpc peering on timeout, then
+// the vpc peering is got again, falling back to the one read before waiting.
+func (r *DedicatedVpcPeeringResource) saveUnreadyVpcPeering(ctx context.Context, lastRead *dedicated.Dedicatedv1beta1VpcPeering,
+	waited *dedicated.Dedicatedv1beta1VpcPeering, data *DedicatedVpcPeeringResourceData, resp *resource.CreateResponse) {
+	vpcPeering := waited
+	if vpcPeering == nil {
+		var err error
+		vpcPeering, err = r.provider.DedicatedClient.GetVPCPeering(ctx, data.VpcPeeringId.ValueString())
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("unable to get dedicated vpc peering %s, got error: %s", data.VpcPeeringId.ValueString(), err))
+			vpcPeering = lastRead
+		}
+	}
+	resp.Diagnostics.Append(refreshDedicatedVpcPeeringResourceData(ctx, vpcPeering, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
 func (r *DedicatedVpcPeeringResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data DedicatedVpcPeeringResourceData
 	diags := req.State.Get(ctx, &data)
@@ -209,15 +409,28 @@ func (r *DedicatedVpcPeeringResource) Read(ctx context.Context, req resource.Rea
 		tflog.Error(ctx, fmt.Sprintf("Unable to call GetVpcPeering, error: %s", err))
 		return
 	}
-	refreshDedicatedVpcPeeringResourceData(ctx, VpcPeering, &data)
+	resp.Diagnostics.Append(refreshDedicatedVpcPeeringResourceData(ctx, VpcPeering, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// save into the Terraform state.
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
 
+// NOTICE: vpc peerings can't be updated through the API, so all the configurable attributes except wait_for_active
+// require replacement. wait_for_active only takes effect on creation and is updated in place.
 func (r *DedicatedVpcPeeringResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError("Update Error", "Update is not supported for dedicated vpc peering")
+	var plan, state DedicatedVpcPeeringResourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.WaitForActive = plan.WaitForActive
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *DedicatedVpcPeeringResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -255,7 +468,8 @@ func buildCreateDedicatedVpcPeeringBody(data DedicatedVpcPeeringResourceData) de
 	}
 }
 
-func refreshDedicatedVpcPeeringResourceData(ctx context.Context, vpcPeering *dedicated.Dedicatedv1beta1VpcPeering, data *DedicatedVpcPeeringResourceData) {
+func refreshDedicatedVpcPeeringResourceData(ctx context.Context, vpcPeering *dedicated.Dedicatedv1beta1VpcPeering, data *DedicatedVpcPeeringResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
 	data.VpcPeeringId = types.StringValue(*vpcPeering.VpcPeeringId)
 	data.State = types.StringValue(string(*vpcPeering.State))
 	data.TiDBCloudCloudProvider = types.StringValue(string(*vpcPeering.TidbCloudCloudProvider))
@@ -273,14 +487,31 @@ func refreshDedicatedVpcPeeringResourceData(ctx context.Context, vpcPeering *ded
 	} else {
 		data.AWSVpcPeeringConnectionId = types.StringNull()
 	}
-	labels, diag := types.MapValueFrom(ctx, types.StringType, *vpcPeering.Labels)
-	if diag.HasError() {
-		return
+	data.GCP = types.ObjectNull(vpcPeeringGCPAttrTypes)
+	data.Azure = types.ObjectNull(vpcPeeringAzureAttrTypes)
+	switch string(*vpcPeering.TidbCloudCloudProvider) {
+	case "gcp":
+		data.GCP, diags = types.ObjectValueFrom(ctx, vpcPeeringGCPAttrTypes, vpcPeeringGCP{
+			PeerProjectId:   data.TiDBCloudAccountId,
+			PeerNetworkName: data.TiDBCloudVpcId,
+			PeerNetwork:     types.StringValue(fmt.Sprintf("projects/%s/global/networks/%s", *vpcPeering.TidbCloudAccountId, *vpcPeering.TidbCloudVpcId)),
+		})
+	case "azure":
+		data.Azure, diags = types.ObjectValueFrom(ctx, vpcPeeringAzureAttrTypes, vpcPeeringAzure{
+			PeerSubscriptionId:   data.TiDBCloudAccountId,
+			PeerVirtualNetworkId: data.TiDBCloudVpcId,
+		})
+	}
+	if diags.HasError() {
+		return diags
 	}
-	data.Labels = labels
+	data.Labels, diags = types.MapValueFrom(ctx, types.StringType, *vpcPeering.Labels)
+	return diags
 }
 
-func WaitDedicatedVpcPeeringReady(ctx context.Context, timeout time.Duration, interval time.Duration, VpcPeeringId string,
+// WaitDedicatedVpcPeeringReady waits until the vpc peering is ready to be accepted in the customer VPC,
+// or until it is ACTIVE if waitForActive is true.
+func WaitDedicatedVpcPeeringReady(ctx context.Context, timeout time.Duration, interval time.Duration, VpcPeeringId string, waitForActive bool,
 	client tidbcloud.TiDBCloudDedicatedClient) (*dedicated.Dedicatedv1beta1VpcPeering, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
@@ -292,7 +523,7 @@ func WaitDedicatedVpcPeeringReady(ctx context.Context, timeout time.Duration, in
 		Timeout:      timeout,
 		MinTimeout:   500 * time.Millisecond,
 		PollInterval: interval,
-		Refresh:      dedicatedVpcPeeringStateRefreshFunc(ctx, VpcPeeringId, client),
+		Refresh:      dedicatedVpcPeeringStateRefreshFunc(ctx, VpcPeeringId, waitForActive, client),
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -303,10 +534,10 @@ func WaitDedicatedVpcPeeringReady(ctx context.Context, timeout time.Duration, in
 	return nil, err
 }
 
-func dedicatedVpcPeeringStateRefreshFunc(ctx context.Context, VpcPeeringId string,
+func dedicatedVpcPeeringStateRefreshFunc(ctx context.Context, VpcPeeringId string, waitForActive bool,
 	client tidbcloud.TiDBCloudDedicatedClient) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		tflog.Trace(ctx, "Waiting for aws_vpc_peering_connection_id")
+		tflog.Trace(ctx, "Waiting for dedicated vpc peering")
 		VpcPeering, err := client.GetVPCPeering(ctx, VpcPeeringId)
 		if err != nil {
 			return nil, "", err
@@ -314,9 +545,25 @@ func dedicatedVpcPeeringStateRefreshFunc(ctx context.Context, VpcPeeringId strin
 		if VpcPeering.State != nil && *VpcPeering.State == dedicated.DEDICATEDV1BETA1VPCPEERINGSTATE_FAILED {
 			return VpcPeering, "failed", fmt.Errorf("dedicated vpc peering is in FAILED state")
 		}
-		if VpcPeering.AwsVpcPeeringConnectionId.IsSet() {
+		if dedicatedVpcPeeringReady(VpcPeering, waitForActive) {
 			return VpcPeering, "ready", nil
 		}
 		return VpcPeering, "pending", nil
 	}
 }
+
+// dedicatedVpcPeeringReady reports whether the vpc peering needs no more waiting. An AWS peering can be
+// accepted once its peering connection is created. A GCP or Azure peering is peered from the customer
+// side, which only needs the TiDB Cloud network, so it can be accepted as soon as it exists.
+func dedicatedVpcPeeringReady(vpcPeering *dedicated.Dedicatedv1beta1VpcPeering, waitForActive bool) bool {
+	if vpcPeering.State != nil && *vpcPeering.State == dedicated.DEDICATEDV1BETA1VPCPEERINGSTATE_ACTIVE {
+		return true
+	}
+	if waitForActive {
+		return false
+	}
+	if vpcPeering.TidbCloudCloudProvider != nil && string(*vpcPeering.TidbCloudCloudProvider) == "aws" {
+		return vpcPeering.AwsVpcPeeringConnectionId.IsSet()
+	}
+	return true
+}
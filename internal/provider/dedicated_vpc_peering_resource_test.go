@@ -2,6 +2,8 @@ package provider
 
 import (
 	"fmt"
+	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -16,7 +18,7 @@ func TestUTDedicatedVPCPeeringResource(t *testing.T) {
 
 	ctrl := gomock.NewController(t)
 	s := mockClient.NewMockTiDBCloudDedicatedClient(ctrl)
-	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string) (tidbcloud.TiDBCloudDedicatedClient, error) {
+	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudDedicatedClient, error) {
 		return s, nil
 	})()
 
@@ -27,6 +29,11 @@ func TestUTDedicatedVPCPeeringResource(t *testing.T) {
 	getVPCPeeringResp := dedicated.Dedicatedv1beta1VpcPeering{}
 	getVPCPeeringResp.UnmarshalJSON([]byte(testUTVPCPeering(string(dedicated.DEDICATEDV1BETA1VPCPEERINGSTATE_ACTIVE))))
 
+	listNetworkContainersResp := dedicated.V1beta1ListNetworkContainersResponse{}
+	listNetworkContainersResp.UnmarshalJSON([]byte(testUTV1beta1ListNetworkContainersResponse))
+
+	s.EXPECT().ListNetworkContainers(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&listNetworkContainersResp, nil).AnyTimes()
+	s.EXPECT().ListVPCPeerings(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&dedicated.Dedicatedv1beta1ListVpcPeeringsResponse{}, nil).AnyTimes()
 	s.EXPECT().CreateVPCPeering(gomock.Any(), gomock.Any()).Return(&createVPCPeeringResp, nil)
 	s.EXPECT().GetVPCPeering(gomock.Any(), vpcPeeringId).Return(&getVPCPeeringResp, nil).AnyTimes()
 	s.EXPECT().DeleteVPCPeering(gomock.Any(), vpcPeeringId).Return(nil)
@@ -34,6 +41,147 @@ func TestUTDedicatedVPCPeeringResource(t *testing.T) {
 	testDedicatedVPCPeeringResource(t)
 }
 
+func TestUTDedicatedVPCPeeringResourceGCP(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudDedicatedClient(ctrl)
+	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudDedicatedClient, error) {
+		return s, nil
+	})()
+
+	vpcPeeringId := "vpcPeering-id"
+
+	// a GCP vpc peering never has an AWS peering connection, and stays PENDING until the customer side peers with it
+	vpcPeeringResp := dedicated.Dedicatedv1beta1VpcPeering{}
+	vpcPeeringResp.UnmarshalJSON([]byte(testUTGCPVPCPeering(string(dedicated.DEDICATEDV1BETA1VPCPEERINGSTATE_PENDING))))
+
+	s.EXPECT().ListNetworkContainers(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&dedicated.V1beta1ListNetworkContainersResponse{}, nil).AnyTimes()
+	s.EXPECT().ListVPCPeerings(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&dedicated.Dedicatedv1beta1ListVpcPeeringsResponse{}, nil).AnyTimes()
+	s.EXPECT().CreateVPCPeering(gomock.Any(), gomock.Any()).Return(&vpcPeeringResp, nil)
+	s.EXPECT().GetVPCPeering(gomock.Any(), vpcPeeringId).Return(&vpcPeeringResp, nil).AnyTimes()
+	s.EXPECT().DeleteVPCPeering(gomock.Any(), vpcPeeringId).Return(nil)
+
+	dedicatedVPCPeeringResourceName := "tidbcloud_dedicated_vpc_peering.test"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUTDedicatedGCPVPCPeeringResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dedicatedVPCPeeringResourceName, "state", "PENDING"),
+					resource.TestCheckNoResourceAttr(dedicatedVPCPeeringResourceName, "aws_vpc_peering_connection_id"),
+					resource.TestCheckResourceAttr(dedicatedVPCPeeringResourceName, "gcp.peer_network", "projects/tidb-cloud-project/global/networks/tidb-cloud-network"),
+					resource.TestCheckNoResourceAttr(dedicatedVPCPeeringResourceName, "azure"),
+				),
+			},
+		},
+	})
+}
+
+func TestUTDedicatedVPCPeeringResourceNotActive(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudDedicatedClient(ctrl)
+	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudDedicatedClient, error) {
+		return s, nil
+	})()
+
+	vpcPeeringId := "vpcPeering-id"
+
+	// the vpc peering gets ready, but fails instead of becoming active
+	pendingVPCPeeringResp := dedicated.Dedicatedv1beta1VpcPeering{}
+	pendingVPCPeeringResp.UnmarshalJSON([]byte(testUTVPCPeering(string(dedicated.DEDICATEDV1BETA1VPCPEERINGSTATE_PENDING))))
+	failedVPCPeeringResp := dedicated.Dedicatedv1beta1VpcPeering{}
+	failedVPCPeeringResp.UnmarshalJSON([]byte(testUTVPCPeering(string(dedicated.DEDICATEDV1BETA1VPCPEERINGSTATE_FAILED))))
+
+	s.EXPECT().ListNetworkContainers(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&dedicated.V1beta1ListNetworkContainersResponse{}, nil).AnyTimes()
+	s.EXPECT().ListVPCPeerings(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&dedicated.Dedicatedv1beta1ListVpcPeeringsResponse{}, nil).AnyTimes()
+	s.EXPECT().CreateVPCPeering(gomock.Any(), gomock.Any()).Return(&pendingVPCPeeringResp, nil)
+	s.EXPECT().GetVPCPeering(gomock.Any(), vpcPeeringId).Return(&pendingVPCPeeringResp, nil).Times(1)
+	s.EXPECT().GetVPCPeering(gomock.Any(), vpcPeeringId).Return(&failedVPCPeeringResp, nil).AnyTimes()
+	// the vpc peering is saved into the state though it's not active, so it's deleted instead of being left behind
+	s.EXPECT().DeleteVPCPeering(gomock.Any(), vpcPeeringId).Return(nil).Times(1)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testUTDedicatedVPCPeeringResourceConfig(true),
+				ExpectError: regexp.MustCompile(`Dedicated vpc peering creation failed`),
+			},
+		},
+	})
+}
+
+func TestUTDedicatedVPCPeeringResourceOverlappingCIDR(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudDedicatedClient(ctrl)
+	defer HookGlobal(&NewDedicatedClient, func(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudDedicatedClient, error) {
+		return s, nil
+	})()
+
+	listNetworkContainersResp := dedicated.V1beta1ListNetworkContainersResponse{}
+	listNetworkContainersResp.UnmarshalJSON([]byte(testUTV1beta1ListNetworkContainersResponse))
+
+	s.EXPECT().ListNetworkContainers(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&listNetworkContainersResp, nil).AnyTimes()
+	s.EXPECT().ListVPCPeerings(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&dedicated.Dedicatedv1beta1ListVpcPeeringsResponse{}, nil).AnyTimes()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// the network container of aws-us-west-2 uses 172.16.0.0/21
+				Config: `
+resource "tidbcloud_dedicated_vpc_peering" "test" {
+	tidb_cloud_region_id = "aws-us-west-2"
+    customer_region_id = "aws-us-west-2"
+    customer_account_id = "customer_account_id"
+    customer_vpc_id = "customer_vpc_id"
+    customer_vpc_cidr = "172.16.4.0/22"
+}
+`,
+				ExpectError: regexp.MustCompile(`Overlapping CIDR Blocks`),
+			},
+			{
+				Config: `
+resource "tidbcloud_dedicated_vpc_peering" "test" {
+	tidb_cloud_region_id = "aws-us-west-2"
+    customer_region_id = "aws-us-west-2"
+    customer_account_id = "customer_account_id"
+    customer_vpc_id = "customer_vpc_id"
+    customer_vpc_cidr = "100.64.0.1/10"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid CIDR Block`),
+			},
+			{
+				// customer VPCs are not limited to the RFC 1918 ranges
+				Config: `
+resource "tidbcloud_dedicated_vpc_peering" "test" {
+	tidb_cloud_region_id = "aws-us-west-2"
+    customer_region_id = "aws-us-west-2"
+    customer_account_id = "customer_account_id"
+    customer_vpc_id = "customer_vpc_id"
+    customer_vpc_cidr = "100.64.0.0/10"
+}
+`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func testDedicatedVPCPeeringResource(t *testing.T) {
 	dedicatedVPCPeeringResourceName := "tidbcloud_dedicated_vpc_peering.test"
 	resource.Test(t, resource.TestCase{
@@ -43,26 +191,34 @@ func testDedicatedVPCPeeringResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read dedicated vpc peering resource
 			{
-				Config: testUTDedicatedVPCPeeringResourceConfig(),
+				Config: testUTDedicatedVPCPeeringResourceConfig(false),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(dedicatedVPCPeeringResourceName, "tidb_cloud_vpc_id", "tidb_cloud_vpc_id"),
 				),
 			},
+			// Update wait_for_active in place, a replacement would create the vpc peering again
+			{
+				Config: testUTDedicatedVPCPeeringResourceConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dedicatedVPCPeeringResourceName, "wait_for_active", "true"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
 
-func testUTDedicatedVPCPeeringResourceConfig() string {
-	return `
+func testUTDedicatedVPCPeeringResourceConfig(waitForActive bool) string {
+	return fmt.Sprintf(`
 resource "tidbcloud_dedicated_vpc_peering" "test" {
 	tidb_cloud_region_id = "aws-us-west-2"
     customer_region_id = "aws-us-west-2"
     customer_account_id = "customer_account_id"
     customer_vpc_id = "customer_vpc_id"
     customer_vpc_cidr = "172.16.32.0/21"
+    wait_for_active = %t
 }
-`
+`, waitForActive)
 }
 
 func testUTVPCPeering(state string) string {
@@ -87,3 +243,35 @@ func testUTVPCPeering(state string) string {
 }
 `, state)
 }
+
+const testUTDedicatedGCPVPCPeeringResourceConfig = `
+resource "tidbcloud_dedicated_vpc_peering" "test" {
+	tidb_cloud_region_id = "gcp-us-west1"
+    customer_region_id = "gcp-us-west1"
+    customer_account_id = "customer-project"
+    customer_vpc_id = "customer-network"
+    customer_vpc_cidr = "172.16.32.0/21"
+}
+`
+
+func testUTGCPVPCPeering(state string) string {
+	return fmt.Sprintf(`
+{
+    "name": "vpcPeerings/vpcPeering-id",
+    "vpcPeeringId": "vpcPeering-id",
+    "labels": {
+        "tidb.cloud/project": "0000000"
+    },
+    "tidbCloudRegionId": "gcp-us-west1",
+    "customerRegionId": "gcp-us-west1",
+    "customerAccountId": "customer-project",
+    "customerVpcId": "customer-network",
+    "customerVpcCidr": "172.16.32.0/21",
+    "tidbCloudCloudProvider": "gcp",
+    "tidbCloudAccountId": "tidb-cloud-project",
+    "tidbCloudVpcId": "tidb-cloud-network",
+    "tidbCloudVpcCidr": "172.16.0.0/21",
+    "state": "%s"
+}
+`, state)
+}
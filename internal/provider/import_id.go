@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// importIDFormats are the fields of the composite import identifiers, keyed by resource type.
+var importIDFormats = map[string][]string{
+	"tidbcloud_backup":                                {"project_id", "cluster_id", "backup_id"},
+	"tidbcloud_cluster":                               {"project_id", "cluster_id"},
+	"tidbcloud_dedicated_node_group":                  {"cluster_id", "node_group_id"},
+	"tidbcloud_dedicated_private_endpoint_connection": {"cluster_id", "node_group_id", "private_endpoint_connection_id"},
+	"tidbcloud_serverless_branch":                     {"cluster_id", "branch_id"},
+	"tidbcloud_serverless_export":                     {"cluster_id", "export_id"},
+	"tidbcloud_sql_user":                              {"cluster_id", "user_name"},
+}
+
+// parseImportID splits a comma separated import identifier into the given fields. Spaces around
+// the parts are ignored and every part must be non-empty.
+func parseImportID(id string, fields ...string) ([]string, error) {
+	parts := strings.Split(id, ",")
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+	valid := len(parts) == len(fields)
+	for _, part := range parts {
+		valid = valid && part != ""
+	}
+	if !valid {
+		return nil, fmt.Errorf("expected import identifier with format: %s. Got: %q", strings.Join(fields, ","), id)
+	}
+	return parts, nil
+}
+
+// importIDFields returns the fields of the import identifier of a resource type.
+func importIDFields(resourceType string) ([]string, error) {
+	fields, ok := importIDFormats[resourceType]
+	if !ok {
+		resourceTypes := make([]string, 0, len(importIDFormats))
+		for t := range importIDFormats {
+			resourceTypes = append(resourceTypes, t)
+		}
+		sort.Strings(resourceTypes)
+		return nil, fmt.Errorf("unsupported resource type %q, available values [%s]", resourceType, strings.Join(resourceTypes, ", "))
+	}
+	return fields, nil
+}
+
+// importStateByFields sets the fields of the import identifier of a resource type into the state.
+// The fields are read from the identity when the resource is imported with an identity, otherwise
+// from the legacy comma separated import identifier. The identity attributes must be named after
+// the fields.
+func importStateByFields(ctx context.Context, resourceType string, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	fields := importIDFormats[resourceType]
+	var values []string
+	if req.ID == "" && req.Identity != nil {
+		values = make([]string, len(fields))
+		for i, field := range fields {
+			resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(field), &values[i])...)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		var err error
+		values, err = parseImportID(req.ID, fields...)
+		if err != nil {
+			resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+			return
+		}
+	}
+
+	for i, field := range fields {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(field), values[i])...)
+	}
+}
+
+// importByNamePrefix is the prefix of the import identifiers which refer to a resource by its
+// display name, e.g. name:<project_id>/<display_name>.
+const importByNamePrefix = "name:"
+
+// parseImportByName splits an import identifier like name:<parent_id>/<display_name>. ok is false
+// if the identifier does not refer to a display name. The display name may contain slashes.
+func parseImportByName(id string, parentField string) (parentId string, displayName string, ok bool, err error) {
+	if !strings.HasPrefix(id, importByNamePrefix) {
+		return "", "", false, nil
+	}
+	parts := strings.SplitN(strings.TrimPrefix(id, importByNamePrefix), "/", 2)
+	if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || parts[1] == "" {
+		return "", "", true, fmt.Errorf("expected import identifier with format: %s<%s>/<display_name>. Got: %q", importByNamePrefix, parentField, id)
+	}
+	return strings.TrimSpace(parts[0]), parts[1], true, nil
+}
+
+// resolveDisplayName returns the ID of the only item with the display name. kind and scope are
+// only used in the errors, e.g. "cluster" and "project 1234".
+func resolveDisplayName[T any](items []T, displayName string, kind string, scope string, nameAndId func(item T) (string, string)) (string, error) {
+	var ids []string
+	for _, item := range items {
+		name, id := nameAndId(item)
+		if name == displayName {
+			ids = append(ids, id)
+		}
+	}
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no %s named %q found in %s", kind, displayName, scope)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%d %ss named %q found in %s: %s. Use the ID of one of them instead", len(ids), kind, displayName, scope, strings.Join(ids, ", "))
+	}
+}
+
+// projectScope describes where clusters are looked up by display name, in the errors of resolveDisplayName.
+func projectScope(projectId string) string {
+	if projectId == "" {
+		return "any project"
+	}
+	return "project " + projectId
+}
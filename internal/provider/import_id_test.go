@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUTParseImportByName(t *testing.T) {
+	tests := []struct {
+		id          string
+		byName      bool
+		wantErr     bool
+		parentId    string
+		displayName string
+	}{
+		{id: "10000000000000000000"},
+		{id: "cluster_id,branch_id"},
+		{id: "name:1234567/my-cluster", byName: true, parentId: "1234567", displayName: "my-cluster"},
+		{id: "name: 1234567 /my cluster/a", byName: true, parentId: "1234567", displayName: "my cluster/a"},
+		{id: "name:1234567", byName: true, wantErr: true},
+		{id: "name:/my-cluster", byName: true, wantErr: true},
+		{id: "name:1234567/", byName: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		parentId, displayName, byName, err := parseImportByName(tt.id, "project_id")
+		if byName != tt.byName || (err != nil) != tt.wantErr {
+			t.Errorf("parseImportByName(%q) = %v, %v, expected %v, error %v", tt.id, byName, err, tt.byName, tt.wantErr)
+			continue
+		}
+		if parentId != tt.parentId || displayName != tt.displayName {
+			t.Errorf("parseImportByName(%q) = %q, %q, expected %q, %q", tt.id, parentId, displayName, tt.parentId, tt.displayName)
+		}
+	}
+}
+
+func TestUTResolveDisplayName(t *testing.T) {
+	items := [][2]string{{"a", "1"}, {"b", "2"}, {"b", "3"}}
+	nameAndId := func(item [2]string) (string, string) { return item[0], item[1] }
+
+	id, err := resolveDisplayName(items, "a", "cluster", "project 1", nameAndId)
+	if err != nil || id != "1" {
+		t.Errorf("expected 1, got %q, %v", id, err)
+	}
+	_, err = resolveDisplayName(items, "b", "cluster", "project 1", nameAndId)
+	if err == nil || !strings.Contains(err.Error(), "2, 3") {
+		t.Errorf("expected ambiguous error, got %v", err)
+	}
+	_, err = resolveDisplayName(items, "c", "cluster", "project 1", nameAndId)
+	if err == nil || !strings.Contains(err.Error(), "no cluster named") {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
@@ -337,7 +337,7 @@ func (r *ImportResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// import with LOCAL type need to upload the file first
 	if data.Type.ValueString() == "LOCAL" {
-		newFileName, uploadError := r.uploadFile(data.FileName.ValueString(), data)
+		newFileName, uploadError := r.uploadFile(ctx, data.FileName.ValueString(), data)
 		if uploadError != nil {
 			resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to uploadFile, got error: %s", uploadError))
 			return
@@ -350,7 +350,7 @@ func (r *ImportResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 	// call CreateImport
 	createImportParams := importService.NewCreateImportParams().WithProjectID(data.ProjectId.ValueString()).WithClusterID(data.ClusterId.ValueString()).WithBody(*body)
-	createImportResp, err := r.provider.client.CreateImport(createImportParams)
+	createImportResp, err := r.provider.client.CreateImport(ctx, createImportParams)
 	if err != nil {
 		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to call CreateImport, got error: %s", err))
 		return
@@ -360,7 +360,7 @@ func (r *ImportResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Refresh for any unknown value.
 	tflog.Trace(ctx, "read import resource")
 	getImportParams := importService.NewGetImportParams().WithProjectID(data.ProjectId.ValueString()).WithClusterID(data.ClusterId.ValueString()).WithID(data.Id.ValueString())
-	getImportResp, err := r.provider.client.GetImport(getImportParams)
+	getImportResp, err := r.provider.client.GetImport(ctx, getImportParams)
 	if err != nil {
 		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to call GetImport, got error: %s", err))
 		return
@@ -428,7 +428,7 @@ func buildCreateImportBody(data *ImportResourceModel) (*importService.CreateImpo
 }
 
 // UploadFile will upload the Local file and return the new url
-func (r *ImportResource) uploadFile(fileName string, data *ImportResourceModel) (string, error) {
+func (r *ImportResource) uploadFile(ctx context.Context, fileName string, data *ImportResourceModel) (string, error) {
 	localFile, err := os.Open(fileName)
 	if err != nil {
 		return "", err
@@ -441,7 +441,7 @@ func (r *ImportResource) uploadFile(fileName string, data *ImportResourceModel)
 	}
 	size := strconv.FormatInt(stat.Size(), 10)
 	name := stat.Name()
-	urlRes, err := r.provider.client.GenerateUploadURL(importService.NewGenerateUploadURLParams().WithProjectID(data.ProjectId.ValueString()).WithClusterID(data.ClusterId.ValueString()).WithBody(importService.GenerateUploadURLBody{
+	urlRes, err := r.provider.client.GenerateUploadURL(ctx, importService.NewGenerateUploadURLParams().WithProjectID(data.ProjectId.ValueString()).WithClusterID(data.ClusterId.ValueString()).WithBody(importService.GenerateUploadURLBody{
 		ContentLength: &size,
 		FileName:      &name,
 	}))
@@ -450,7 +450,7 @@ func (r *ImportResource) uploadFile(fileName string, data *ImportResourceModel)
 	}
 	url := urlRes.Payload.UploadURL
 
-	err = r.provider.client.PreSignedUrlUpload(url, localFile, stat.Size())
+	err = r.provider.client.PreSignedUrlUpload(ctx, url, localFile, stat.Size())
 	if err != nil {
 		return "", err
 	}
@@ -496,7 +496,7 @@ func (r *ImportResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	tflog.Trace(ctx, "read import resource")
 	getImportParams := importService.NewGetImportParams().WithProjectID(data.ProjectId.ValueString()).WithClusterID(data.ClusterId.ValueString()).WithID(data.Id.ValueString())
-	getImportResp, err := r.provider.client.GetImport(getImportParams)
+	getImportResp, err := r.provider.client.GetImport(ctx, getImportParams)
 	if err != nil {
 		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to call GetClusterById, got error: %s", err))
 		return
@@ -559,7 +559,7 @@ func (r *ImportResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	tflog.Trace(ctx, "delete import resource")
 	cancelImportParams := importService.NewCancelImportParams().WithProjectID(data.ProjectId.ValueString()).WithClusterID(data.ClusterId.ValueString()).WithID(data.Id.ValueString())
-	_, err := r.provider.client.CancelImport(cancelImportParams)
+	_, err := r.provider.client.CancelImport(ctx, cancelImportParams)
 	if err != nil {
 		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Unable to call CancelImport, got error: %s", err))
 		return
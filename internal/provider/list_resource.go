@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// listResults returns the results of a list resource, one result per item, up to the limit of
+// the request. fill sets the identity, the display name and, if the request includes the
+// resource, the resource of a result.
+func listResults[T any](ctx context.Context, req list.ListRequest, items []T, fill func(item T, result *list.ListResult)) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i, item := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+			result := req.NewListResult(ctx)
+			fill(item, &result)
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// listError returns the results of a list resource which failed before listing any item.
+func listError(summary string, detail string) iter.Seq[list.ListResult] {
+	var diags diag.Diagnostics
+	diags.AddError(summary, detail)
+	return list.ListResultsStreamDiagnostics(diags)
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type parseImportIDFunction struct{}
+
+var _ function.Function = &parseImportIDFunction{}
+
+func NewParseImportIDFunction() function.Function {
+	return &parseImportIDFunction{}
+}
+
+func (f *parseImportIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_import_id"
+}
+
+func (f *parseImportIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse an import identifier",
+		MarkdownDescription: "Parses the comma separated import identifier of a resource into a map keyed by the field names, e.g. `parse_import_id(\"tidbcloud_sql_user\", \"12345,prefix.root\")` returns `{cluster_id = \"12345\", user_name = \"prefix.root\"}`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "resource_type",
+				MarkdownDescription: "The resource type, e.g. tidbcloud_sql_user.",
+			},
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "The import identifier.",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *parseImportIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var resourceType, id string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &resourceType, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	fields, err := importIDFields(resourceType)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	parts, err := parseImportID(id, fields...)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+	result := make(map[string]string, len(fields))
+	for i, field := range fields {
+		result[field] = parts[i]
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
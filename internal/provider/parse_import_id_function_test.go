@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestUTParseImportIDFunction(t *testing.T) {
+	setupTestEnv()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "cluster_id" {
+  value = provider::tidbcloud::parse_import_id("tidbcloud_sql_user", "12345, prefix.root")["cluster_id"]
+}
+output "user_name" {
+  value = provider::tidbcloud::parse_import_id("tidbcloud_sql_user", "12345, prefix.root")["user_name"]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("cluster_id", "12345"),
+					resource.TestCheckOutput("user_name", "prefix.root"),
+				),
+			},
+			{
+				Config: `
+output "id" {
+  value = provider::tidbcloud::parse_import_id("tidbcloud_serverless_branch", "12345")
+}
+`,
+				ExpectError: regexp.MustCompile(`expected import identifier with format: cluster_id,branch_id`),
+			},
+			{
+				Config: `
+output "id" {
+  value = provider::tidbcloud::parse_import_id("tidbcloud_unknown", "12345")
+}
+`,
+				ExpectError: regexp.MustCompile(`unsupported resource type`),
+			},
+		},
+	})
+}
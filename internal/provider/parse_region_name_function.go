@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const regionNamePrefix = "regions/"
+
+var cloudProviders = []string{"aws", "gcp", "azure", "alicloud"}
+
+var regionNameAttrTypes = map[string]attr.Type{
+	"name":           types.StringType,
+	"region_id":      types.StringType,
+	"cloud_provider": types.StringType,
+	"region_code":    types.StringType,
+}
+
+type parseRegionNameFunction struct{}
+
+var _ function.Function = &parseRegionNameFunction{}
+
+func NewParseRegionNameFunction() function.Function {
+	return &parseRegionNameFunction{}
+}
+
+func (f *parseRegionNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_region_name"
+}
+
+func (f *parseRegionNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse a region name",
+		MarkdownDescription: "Parses a region name like `regions/aws-us-east-1` or a region ID like `aws-us-east-1` into an object with the attributes `name`, `region_id`, `cloud_provider` and `region_code`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The region name or region ID.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: regionNameAttrTypes,
+		},
+	}
+}
+
+func (f *parseRegionNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	cloudProvider, regionCode, err := parseRegionName(name)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	regionId := cloudProvider + "-" + regionCode
+	result, diags := types.ObjectValue(regionNameAttrTypes, map[string]attr.Value{
+		"name":           types.StringValue(regionNamePrefix + regionId),
+		"region_id":      types.StringValue(regionId),
+		"cloud_provider": types.StringValue(cloudProvider),
+		"region_code":    types.StringValue(regionCode),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// parseRegionName splits a region name like regions/aws-us-east-1, or a region ID like
+// aws-us-east-1, into the cloud provider and the region code.
+func parseRegionName(name string) (string, string, error) {
+	regionId := strings.TrimPrefix(name, regionNamePrefix)
+	for _, cloudProvider := range cloudProviders {
+		if regionCode, ok := strings.CutPrefix(regionId, cloudProvider+"-"); ok && regionCode != "" {
+			return cloudProvider, regionCode, nil
+		}
+	}
+	return "", "", fmt.Errorf("invalid region name %q, expected format: regions/<cloud_provider>-<region_code> with cloud provider in [%s]",
+		name, strings.Join(cloudProviders, ", "))
+}
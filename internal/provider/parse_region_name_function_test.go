@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestUTParseRegionNameFunction(t *testing.T) {
+	setupTestEnv()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "cloud_provider" {
+  value = provider::tidbcloud::parse_region_name("regions/aws-us-east-1").cloud_provider
+}
+output "region_code" {
+  value = provider::tidbcloud::parse_region_name("regions/aws-us-east-1").region_code
+}
+output "name" {
+  value = provider::tidbcloud::parse_region_name("gcp-asia-southeast1").name
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("cloud_provider", "aws"),
+					resource.TestCheckOutput("region_code", "us-east-1"),
+					resource.TestCheckOutput("name", "regions/gcp-asia-southeast1"),
+				),
+			},
+			{
+				Config: `
+output "region" {
+  value = provider::tidbcloud::parse_region_name("regions/us-east-1")
+}
+`,
+				ExpectError: regexp.MustCompile(`invalid region name`),
+			},
+		},
+	})
+}
@@ -143,7 +143,7 @@ func (d *projectsDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	tflog.Trace(ctx, "read projects data source")
-	listProjectsOK, err := d.provider.client.ListProjects(projectApi.NewListProjectsParams().WithPage(&page).WithPageSize(&pageSize))
+	listProjectsOK, err := d.provider.client.ListProjects(ctx, projectApi.NewListProjectsParams().WithPage(&page).WithPageSize(&pageSize))
 	if err != nil {
 		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to call read project, got error: %s", err))
 		return
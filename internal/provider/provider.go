@@ -3,9 +3,15 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -15,6 +21,10 @@ import (
 
 // Ensure the implementation satisfies the provider.Provider interface.
 var _ provider.Provider = &tidbcloudProvider{}
+var _ provider.ProviderWithEphemeralResources = &tidbcloudProvider{}
+var _ provider.ProviderWithFunctions = &tidbcloudProvider{}
+var _ provider.ProviderWithListResources = &tidbcloudProvider{}
+var _ provider.ProviderWithActions = &tidbcloudProvider{}
 
 // NewClient overrides the NewClientDelegate method for testing.
 var NewClient = tidbcloud.NewClientDelegate
@@ -39,6 +49,9 @@ type tidbcloudProvider struct {
 
 	IAMClient tidbcloud.TiDBCloudIAMClient
 
+	// catalog caches the catalog lookups, like regions and cloud providers, for the whole run.
+	catalog *catalogCache
+
 	// configured is set to true at the end of the Configure method.
 	// This can be used in Resource and DataSource implementations to verify
 	// that the provider was previously configured.
@@ -54,9 +67,15 @@ type tidbcloudProvider struct {
 
 // providerData can be used to store data from the Terraform configuration.
 type providerData struct {
-	PublicKey  types.String `tfsdk:"public_key"`
-	PrivateKey types.String `tfsdk:"private_key"`
-	Sync       types.Bool   `tfsdk:"sync"`
+	PublicKey          types.String `tfsdk:"public_key"`
+	PrivateKey         types.String `tfsdk:"private_key"`
+	Sync               types.Bool   `tfsdk:"sync"`
+	HTTPProxy          types.String `tfsdk:"http_proxy"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
 }
 
 func (p *tidbcloudProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -106,12 +125,37 @@ func (p *tidbcloudProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
+	// Build the base transport shared by all the clients
+	baseTransport, err := tidbcloud.NewBaseTransport(&tidbcloud.TransportConfig{
+		HTTPProxy:          data.HTTPProxy.ValueString(),
+		CACertFile:         data.CACertFile.ValueString(),
+		CACertPEM:          data.CACertPEM.ValueString(),
+		ClientCert:         data.ClientCert.ValueString(),
+		ClientKey:          data.ClientKey.ValueString(),
+		InsecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create client",
+			"Unable to build the HTTP transport:\n\n"+err.Error(),
+		)
+		return
+	}
+
+	// Log the requests and responses of each client through its own tflog subsystem if enabled
+	clientTransport := func(subsystem string) http.RoundTripper {
+		if enabled, _ := strconv.ParseBool(os.Getenv(TiDBCloudHTTPLog)); enabled {
+			return tidbcloud.NewLoggingTransport(baseTransport, subsystem)
+		}
+		return baseTransport
+	}
+
 	// Create a new tidb client and set it to the provider client
 	var host = tidbcloud.DefaultApiUrl
 	if os.Getenv(TiDBCloudHost) != "" {
 		host = os.Getenv(TiDBCloudHost)
 	}
-	c, err := NewClient(publicKey, privateKey, host, fmt.Sprintf("%s/%s", UserAgent, p.version))
+	c, err := NewClient(publicKey, privateKey, host, fmt.Sprintf("%s/%s", UserAgent, p.version), clientTransport(tidbcloud.LogSubsystemLegacy))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create client",
@@ -121,7 +165,7 @@ func (p *tidbcloudProvider) Configure(ctx context.Context, req provider.Configur
 	}
 
 	// Create a new dedicated client and set it to the provider dedicated client
-	dc, err := NewDedicatedClient(publicKey, privateKey, os.Getenv(TiDBCloudDedicatedEndpoint), fmt.Sprintf("%s/%s", UserAgent, p.version))
+	dc, err := NewDedicatedClient(publicKey, privateKey, os.Getenv(TiDBCloudDedicatedEndpoint), fmt.Sprintf("%s/%s", UserAgent, p.version), clientTransport(tidbcloud.LogSubsystemDedicated))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create client",
@@ -131,7 +175,7 @@ func (p *tidbcloudProvider) Configure(ctx context.Context, req provider.Configur
 	}
 
 	// Create a new serverless client and set it to the provider serverless client
-	sc, err := NewServerlessClient(publicKey, privateKey, os.Getenv(TiDBCloudServerlessEndpoint), fmt.Sprintf("%s/%s", UserAgent, p.version))
+	sc, err := NewServerlessClient(publicKey, privateKey, os.Getenv(TiDBCloudServerlessEndpoint), fmt.Sprintf("%s/%s", UserAgent, p.version), clientTransport(tidbcloud.LogSubsystemServerless))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create client",
@@ -140,7 +184,7 @@ func (p *tidbcloudProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
-	ic, err := NewIAMClient(publicKey, privateKey, os.Getenv(TiDBCloudIAMEndpoint), fmt.Sprintf("%s/%s", UserAgent, p.version))
+	ic, err := NewIAMClient(publicKey, privateKey, os.Getenv(TiDBCloudIAMEndpoint), fmt.Sprintf("%s/%s", UserAgent, p.version), clientTransport(tidbcloud.LogSubsystemIAM))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create client",
@@ -153,9 +197,13 @@ func (p *tidbcloudProvider) Configure(ctx context.Context, req provider.Configur
 	p.DedicatedClient = dc
 	p.ServerlessClient = sc
 	p.IAMClient = ic
+	p.catalog = newCatalogCache(catalogCacheTTL)
 	p.configured = true
 	resp.ResourceData = p
 	resp.DataSourceData = p
+	resp.EphemeralResourceData = p
+	resp.ListResourceData = p
+	resp.ActionData = p
 }
 
 func (p *tidbcloudProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -214,6 +262,42 @@ func (p *tidbcloudProvider) DataSources(ctx context.Context) []func() datasource
 		NewServerlessExportsDataSource,
 		NewServerlessBranchDataSource,
 		NewServerlessBranchesDataSource,
+		NewServerlessPrivateLinkServiceDataSource,
+	}
+}
+
+func (p *tidbcloudProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewSQLUserCredentialsEphemeralResource,
+		NewConnectionInfoEphemeralResource,
+	}
+}
+
+func (p *tidbcloudProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewSQLUserListResource,
+		NewDedicatedClusterListResource,
+		NewDedicatedNodeGroupListResource,
+		NewServerlessClusterListResource,
+		NewServerlessBranchListResource,
+	}
+}
+
+func (p *tidbcloudProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewParseRegionNameFunction,
+		NewServerlessUsernameFunction,
+		NewBuildDSNFunction,
+		NewParseImportIDFunction,
+	}
+}
+
+func (p *tidbcloudProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewDedicatedClusterPauseAction,
+		NewDedicatedClusterResumeAction,
+		NewDedicatedClusterRotateRootPasswordAction,
+		NewServerlessBranchResetAction,
 	}
 }
 
@@ -235,6 +319,31 @@ func (p *tidbcloudProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:            true,
 				Sensitive:           false,
 			},
+			"http_proxy": schema.StringAttribute{
+				MarkdownDescription: "The proxy URL used for all requests, e.g. `http://proxy.example.com:3128`. Defaults to the `HTTP_PROXY`, `HTTPS_PROXY` and `NO_PROXY` environment variables.",
+				Optional:            true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM encoded CA bundle trusted in addition to the system roots.",
+				Optional:            true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA bundle trusted in addition to the system roots.",
+				Optional:            true,
+			},
+			"client_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate, or a path to it, presented for mutual TLS. Must be set together with `client_key`.",
+				Optional:            true,
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client private key, or a path to it, presented for mutual TLS. Must be set together with `client_cert`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Whether to skip the verification of the server certificate. Only use it for testing.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -260,14 +260,14 @@ func (r restoreResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	tflog.Trace(ctx, "create restore resource")
-	createRestoreTaskOK, err := r.provider.client.CreateRestoreTask(restoreApi.NewCreateRestoreTaskParams().WithProjectID(data.ProjectId).WithBody(buildCreateRestoreTaskBody(data)))
+	createRestoreTaskOK, err := r.provider.client.CreateRestoreTask(ctx, restoreApi.NewCreateRestoreTaskParams().WithProjectID(data.ProjectId).WithBody(buildCreateRestoreTaskBody(data)))
 	if err != nil {
 		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to call CreateRestoreTask, got error: %s", err))
 		return
 	}
 
 	tflog.Trace(ctx, "read restore resource")
-	getRestoreTaskOK, err := r.provider.client.GetRestoreTask(restoreApi.NewGetRestoreTaskParams().WithProjectID(data.ProjectId).WithRestoreID(createRestoreTaskOK.Payload.ID))
+	getRestoreTaskOK, err := r.provider.client.GetRestoreTask(ctx, restoreApi.NewGetRestoreTaskParams().WithProjectID(data.ProjectId).WithRestoreID(createRestoreTaskOK.Payload.ID))
 	if err != nil {
 		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to call GetRestoreTask, got error: %s", err))
 		return
@@ -343,7 +343,7 @@ func (r restoreResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	tflog.Trace(ctx, "read restore resource")
-	getRestoreTaskOK, err := r.provider.client.GetRestoreTask(restoreApi.NewGetRestoreTaskParams().WithProjectID(data.ProjectId).WithRestoreID(data.RestoreId.ValueString()))
+	getRestoreTaskOK, err := r.provider.client.GetRestoreTask(ctx, restoreApi.NewGetRestoreTaskParams().WithProjectID(data.ProjectId).WithRestoreID(data.RestoreId.ValueString()))
 	if err != nil {
 		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to call GetRestoreTask, got error: %s", err))
 		return
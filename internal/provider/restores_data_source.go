@@ -157,7 +157,7 @@ func (d *restoresDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	tflog.Trace(ctx, "read restores data source")
-	listRestoreTasksOK, err := d.provider.client.ListRestoreTasks(restoreApi.NewListRestoreTasksParams().WithProjectID(data.ProjectId).WithPage(&page).WithPageSize(&pageSize))
+	listRestoreTasksOK, err := d.provider.client.ListRestoreTasks(ctx, restoreApi.NewListRestoreTasksParams().WithProjectID(data.ProjectId).WithPage(&page).WithPageSize(&pageSize))
 	if err != nil {
 		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to call GetRestoreTasks, got error: %s", err))
 		return
@@ -29,6 +29,7 @@ type serverlessBranchDataSourceData struct {
 }
 
 var _ datasource.DataSource = &serverlessBranchDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &serverlessBranchDataSource{}
 
 type serverlessBranchDataSource struct {
 	provider *tidbcloudProvider
@@ -62,11 +63,13 @@ func (d *serverlessBranchDataSource) Schema(_ context.Context, _ datasource.Sche
 				Required:            true,
 			},
 			"branch_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the branch.",
-				Required:            true,
+				MarkdownDescription: "The ID of the branch. Exactly one of branch_id and display_name must be set.",
+				Optional:            true,
+				Computed:            true,
 			},
 			"display_name": schema.StringAttribute{
-				MarkdownDescription: "The display name of the branch.",
+				MarkdownDescription: "The display name of the branch. Exactly one of branch_id and display_name must be set. An error is returned if no branch or more than one branch of the cluster has the display name.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"parent_id": schema.StringAttribute{
@@ -163,6 +166,12 @@ func (d *serverlessBranchDataSource) Schema(_ context.Context, _ datasource.Sche
 	}
 }
 
+func (d *serverlessBranchDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		exactlyOneOf("branch_id", "display_name"),
+	}
+}
+
 func (d *serverlessBranchDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data serverlessBranchDataSourceData
 	diags := req.Config.Get(ctx, &data)
@@ -172,7 +181,24 @@ func (d *serverlessBranchDataSource) Read(ctx context.Context, req datasource.Re
 	}
 
 	tflog.Trace(ctx, "read serverless branch data source")
-	branch, err := d.provider.ServerlessClient.GetBranch(ctx, data.ClusterId.ValueString(), data.BranchId.ValueString(), branchV1beta1.BRANCHSERVICEGETBRANCHVIEWPARAMETER_FULL)
+	clusterId := data.ClusterId.ValueString()
+	branchId := data.BranchId.ValueString()
+	if IsKnown(data.DisplayName) {
+		branches, err := serverlessBranchesDataSource{provider: d.provider}.retrieveBranches(ctx, clusterId)
+		if err != nil {
+			resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to call ListBranches, got error: %s", err))
+			return
+		}
+		branchId, err = resolveDisplayName(branches, data.DisplayName.ValueString(), "branch", "cluster "+clusterId,
+			func(b branchV1beta1.Branch) (string, string) {
+				return b.DisplayName, *b.BranchId
+			})
+		if err != nil {
+			resp.Diagnostics.AddError("Read Error", err.Error())
+			return
+		}
+	}
+	branch, err := d.provider.ServerlessClient.GetBranch(ctx, clusterId, branchId, branchV1beta1.BRANCHSERVICEGETBRANCHVIEWPARAMETER_FULL)
 	if err != nil {
 		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to call GetBranch, got error: %s", err))
 		return
@@ -183,6 +209,7 @@ func (d *serverlessBranchDataSource) Read(ctx context.Context, req datasource.Re
 		diags.AddError("Read Error", "unable to convert annotations")
 		return
 	}
+	data.BranchId = types.StringValue(*branch.BranchId)
 	data.DisplayName = types.StringValue(branch.DisplayName)
 	data.ParentId = types.StringValue(*branch.ParentId)
 	data.ParentDisplayName = types.StringValue(*branch.ParentDisplayName)
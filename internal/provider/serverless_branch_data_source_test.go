@@ -1,6 +1,9 @@
 package provider
 
 import (
+	"fmt"
+	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -32,7 +35,7 @@ func TestUTServerlessBranchDataSource(t *testing.T) {
 
 	ctrl := gomock.NewController(t)
 	s := mockClient.NewMockTiDBCloudServerlessClient(ctrl)
-	defer HookGlobal(&NewServerlessClient, func(publicKey string, privateKey string, serverlessEndpoint string, userAgent string) (tidbcloud.TiDBCloudServerlessClient, error) {
+	defer HookGlobal(&NewServerlessClient, func(publicKey string, privateKey string, serverlessEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudServerlessClient, error) {
 		return s, nil
 	})()
 
@@ -46,6 +49,43 @@ func TestUTServerlessBranchDataSource(t *testing.T) {
 	testUTServerlessBranchDataSource(t, branchId)
 }
 
+func TestUTServerlessBranchDataSourceByDisplayName(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudServerlessClient(ctrl)
+	defer HookGlobal(&NewServerlessClient, func(publicKey string, privateKey string, serverlessEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudServerlessClient, error) {
+		return s, nil
+	})()
+
+	listBranchesResp := branchV1beta1.ListBranchesResponse{}
+	listBranchesResp.UnmarshalJSON([]byte(testUTListBranchesResponse))
+	getBranchResp := branchV1beta1.Branch{}
+	getBranchResp.UnmarshalJSON([]byte(testUTBranchFull(string(branchV1beta1.BRANCHSTATE_ACTIVE))))
+
+	s.EXPECT().ListBranches(gomock.Any(), "clusterId", gomock.Any(), nil).Return(&listBranchesResp, nil).AnyTimes()
+	s.EXPECT().GetBranch(gomock.Any(), "clusterId", "branchId", branchV1beta1.BRANCHSERVICEGETBRANCHVIEWPARAMETER_FULL).Return(&getBranchResp, nil).AnyTimes()
+
+	serverlessBranchDataSourceName := "data.tidbcloud_serverless_branch.test"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUTServerlessBranchDataSourceByDisplayNameConfig("test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(serverlessBranchDataSourceName, "branch_id", "branchId"),
+				),
+			},
+			{
+				Config:      testUTServerlessBranchDataSourceByDisplayNameConfig("not-exist"),
+				ExpectError: regexp.MustCompile(`no branch named "not-exist" found in cluster clusterId`),
+			},
+		},
+	})
+}
+
 func testUTServerlessBranchDataSource(t *testing.T, branchId string) {
 	serverlessBranchDataSourceName := "data.tidbcloud_serverless_branch.test"
 	resource.Test(t, resource.TestCase{
@@ -91,3 +131,12 @@ data "tidbcloud_serverless_branch" "test" {
 }
 `
 }
+
+func testUTServerlessBranchDataSourceByDisplayNameConfig(displayName string) string {
+	return fmt.Sprintf(`
+data "tidbcloud_serverless_branch" "test" {
+	cluster_id   = "clusterId"
+	display_name = "%s"
+}
+`, displayName)
+}
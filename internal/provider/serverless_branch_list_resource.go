@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	branchV1beta1 "github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/serverless/branch"
+)
+
+type serverlessBranchListConfig struct {
+	ClusterId types.String `tfsdk:"cluster_id"`
+}
+
+type serverlessBranchListResource struct {
+	provider *tidbcloudProvider
+}
+
+var _ list.ListResourceWithConfigure = &serverlessBranchListResource{}
+
+func NewServerlessBranchListResource() list.ListResource {
+	return &serverlessBranchListResource{}
+}
+
+func (r *serverlessBranchListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_serverless_branch"
+}
+
+func (r *serverlessBranchListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	var ok bool
+	if r.provider, ok = req.ProviderData.(*tidbcloudProvider); !ok {
+		resp.Diagnostics.AddError("Internal provider error",
+			fmt.Sprintf("Error in Configure: expected %T but got %T", tidbcloudProvider{}, req.ProviderData))
+	}
+}
+
+func (r *serverlessBranchListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the branches of a serverless cluster.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the cluster.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *serverlessBranchListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config serverlessBranchListConfig
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Trace(ctx, "list serverless_branch_resource")
+	branches, err := (&serverlessBranchesDataSource{provider: r.provider}).retrieveBranches(ctx, config.ClusterId.ValueString())
+	if err != nil {
+		stream.Results = listError("List Error", fmt.Sprintf("Unable to call ListBranches, got error: %s", err))
+		return
+	}
+
+	stream.Results = listResults(ctx, req, branches, func(branch branchV1beta1.Branch, result *list.ListResult) {
+		result.DisplayName = branch.DisplayName
+		result.Diagnostics.Append(result.Identity.Set(ctx, serverlessBranchResourceIdentity{
+			ClusterId: config.ClusterId,
+			BranchId:  types.StringValue(*branch.BranchId),
+		})...)
+		if !req.IncludeResource {
+			return
+		}
+		data := serverlessBranchResourceData{
+			ClusterId: config.ClusterId,
+			BranchId:  types.StringValue(*branch.BranchId),
+		}
+		if err := refreshServerlessBranchResourceData(ctx, &branch, &data); err != nil {
+			result.Diagnostics.AddError("List Error", fmt.Sprintf("Unable to refresh serverless branch resource data, got error: %s", err))
+			return
+		}
+		result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+	})
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	mockClient "github.com/tidbcloud/terraform-provider-tidbcloud/mock"
+	"github.com/tidbcloud/terraform-provider-tidbcloud/tidbcloud"
+	branchV1beta1 "github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/serverless/branch"
+)
+
+func TestUTServerlessBranchListResource(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudServerlessClient(ctrl)
+	defer HookGlobal(&NewServerlessClient, func(publicKey string, privateKey string, serverlessEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudServerlessClient, error) {
+		return s, nil
+	})()
+
+	resp := branchV1beta1.ListBranchesResponse{}
+	resp.UnmarshalJSON([]byte(testUTListBranchesResponse))
+
+	s.EXPECT().ListBranches(gomock.Any(), "clusterId", gomock.Any(), nil).
+		Return(&resp, nil).AnyTimes()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		PreCheck:   func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUTServerlessBranchesConfig,
+			},
+			{
+				Query: true,
+				Config: `
+provider "tidbcloud" {}
+
+list "tidbcloud_serverless_branch" "test" {
+  provider = tidbcloud
+
+  config {
+    cluster_id = "clusterId"
+  }
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("tidbcloud_serverless_branch.test", 1),
+					querycheck.ExpectIdentity("tidbcloud_serverless_branch.test", map[string]knownvalue.Check{
+						"cluster_id": knownvalue.StringExact("clusterId"),
+						"branch_id":  knownvalue.StringExact("branchId"),
+					}),
+				},
+			},
+		},
+	})
+}
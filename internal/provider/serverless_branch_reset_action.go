@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/tidbcloud/terraform-provider-tidbcloud/tidbcloud"
+	branchV1beta1 "github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/serverless/branch"
+)
+
+// serverlessBranchResetStartTimeout is how long to wait for the branch to leave ACTIVE after the
+// reset is accepted. The branch may be ACTIVE for a while before the reset starts.
+const serverlessBranchResetStartTimeout = time.Minute
+
+type serverlessBranchResetActionData struct {
+	ClusterId         types.String `tfsdk:"cluster_id"`
+	BranchId          types.String `tfsdk:"branch_id"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
+}
+
+type serverlessBranchResetAction struct {
+	provider *tidbcloudProvider
+}
+
+var _ action.ActionWithConfigure = &serverlessBranchResetAction{}
+
+func NewServerlessBranchResetAction() action.Action {
+	return &serverlessBranchResetAction{}
+}
+
+func (a *serverlessBranchResetAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_serverless_branch_reset"
+}
+
+func (a *serverlessBranchResetAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	var ok bool
+	if a.provider, ok = req.ProviderData.(*tidbcloudProvider); !ok {
+		resp.Diagnostics.AddError("Internal provider error",
+			fmt.Sprintf("Error in Configure: expected %T but got %T", tidbcloudProvider{}, req.ProviderData))
+	}
+}
+
+func (a *serverlessBranchResetAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resets a branch to the latest data of its parent. All the data written to the branch is lost.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the cluster.",
+				Required:            true,
+			},
+			"branch_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the branch.",
+				Required:            true,
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait until the branch is active again. Default is true.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *serverlessBranchResetAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if !a.provider.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	var data serverlessBranchResetActionData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	clusterId := data.ClusterId.ValueString()
+	branchId := data.BranchId.ValueString()
+
+	tflog.Trace(ctx, "invoke serverless_branch_reset_action")
+	branch, err := a.provider.ServerlessClient.ResetBranch(ctx, clusterId, branchId)
+	if err != nil {
+		resp.Diagnostics.AddError("Reset Error", fmt.Sprintf("Unable to call ResetBranch, got error: %s", err))
+		return
+	}
+
+	if IsKnown(data.WaitForCompletion) && !data.WaitForCompletion.ValueBool() {
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Waiting for branch %s to be reset", branchId)})
+	// wait for the reset to start first, otherwise the branch which is still ACTIVE is taken as reset.
+	if branch.State == nil || *branch.State == branchV1beta1.BRANCHSTATE_ACTIVE {
+		_, err := waitServerlessBranchState(ctx, serverlessBranchResetStartTimeout, serverlessBranchCreateInterval, clusterId, branchId,
+			[]string{string(branchV1beta1.BRANCHSTATE_ACTIVE)},
+			[]string{
+				string(branchV1beta1.BRANCHSTATE_RESTORING),
+				string(branchV1beta1.BRANCHSTATE_CREATING),
+				string(branchV1beta1.BRANCHSTATE_MAINTENANCE),
+				string(branchV1beta1.BRANCHSTATE_DELETED),
+			}, a.provider.ServerlessClient)
+		var timeoutErr *retry.TimeoutError
+		if errors.As(err, &timeoutErr) {
+			// the reset may have finished between two polls.
+			tflog.Warn(ctx, fmt.Sprintf("Branch %s is still ACTIVE %s after the reset", branchId, serverlessBranchResetStartTimeout))
+		} else if err != nil {
+			resp.Diagnostics.AddError("Wait Error", fmt.Sprintf("Branch %s is not reset, got error: %s", branchId, err))
+			return
+		}
+	}
+	if _, err := WaitServerlessBranchReady(ctx, serverlessBranchCreateTimeout, serverlessBranchCreateInterval, clusterId, branchId, a.provider.ServerlessClient); err != nil {
+		resp.Diagnostics.AddError("Wait Error", fmt.Sprintf("Branch %s is not ready after reset, got error: %s", branchId, err))
+	}
+}
+
+// waitServerlessBranchState waits until the branch leaves the pending states for one of the target states.
+func waitServerlessBranchState(ctx context.Context, timeout time.Duration, interval time.Duration, clusterId string, branchId string, pending []string, target []string,
+	client tidbcloud.TiDBCloudServerlessClient) (*branchV1beta1.Branch, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      pending,
+		Target:       target,
+		Timeout:      timeout,
+		MinTimeout:   500 * time.Millisecond,
+		PollInterval: interval,
+		Refresh:      serverlessBranchStateRefreshFunc(ctx, clusterId, branchId, client),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*branchV1beta1.Branch); ok {
+		return output, err
+	}
+	return nil, err
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	mockClient "github.com/tidbcloud/terraform-provider-tidbcloud/mock"
+	"github.com/tidbcloud/terraform-provider-tidbcloud/tidbcloud"
+	branchV1beta1 "github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/serverless/branch"
+)
+
+func TestUTServerlessBranchResetAction(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudServerlessClient(ctrl)
+	defer HookGlobal(&NewServerlessClient, func(publicKey string, privateKey string, serverlessEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudServerlessClient, error) {
+		return s, nil
+	})()
+
+	clusterId := "clusterId"
+	branchId := "branchId"
+
+	resetBranchResp := branchV1beta1.Branch{}
+	resetBranchResp.UnmarshalJSON([]byte(testUTBranch(string(branchV1beta1.BRANCHSTATE_RESTORING))))
+	getBranchResp := branchV1beta1.Branch{}
+	getBranchResp.UnmarshalJSON([]byte(testUTBranch(string(branchV1beta1.BRANCHSTATE_ACTIVE))))
+
+	s.EXPECT().ResetBranch(gomock.Any(), clusterId, branchId).Return(&resetBranchResp, nil).Times(1)
+	s.EXPECT().GetBranch(gomock.Any(), clusterId, branchId, branchV1beta1.BRANCHSERVICEGETBRANCHVIEWPARAMETER_BASIC).Return(&getBranchResp, nil).AnyTimes()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		PreCheck:   func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUTServerlessBranchResetActionConfig,
+			},
+		},
+	})
+}
+
+func TestUTServerlessBranchResetActionWaitsForResetToStart(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudServerlessClient(ctrl)
+	defer HookGlobal(&NewServerlessClient, func(publicKey string, privateKey string, serverlessEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudServerlessClient, error) {
+		return s, nil
+	})()
+
+	clusterId := "clusterId"
+	branchId := "branchId"
+
+	activeBranchResp := branchV1beta1.Branch{}
+	activeBranchResp.UnmarshalJSON([]byte(testUTBranch(string(branchV1beta1.BRANCHSTATE_ACTIVE))))
+	restoringBranchResp := branchV1beta1.Branch{}
+	restoringBranchResp.UnmarshalJSON([]byte(testUTBranch(string(branchV1beta1.BRANCHSTATE_RESTORING))))
+
+	// the branch is still ACTIVE when the reset is accepted, the action must not return before it is RESTORING.
+	s.EXPECT().ResetBranch(gomock.Any(), clusterId, branchId).Return(&activeBranchResp, nil).Times(1)
+	gomock.InOrder(
+		s.EXPECT().GetBranch(gomock.Any(), clusterId, branchId, branchV1beta1.BRANCHSERVICEGETBRANCHVIEWPARAMETER_BASIC).Return(&activeBranchResp, nil).Times(1),
+		s.EXPECT().GetBranch(gomock.Any(), clusterId, branchId, branchV1beta1.BRANCHSERVICEGETBRANCHVIEWPARAMETER_BASIC).Return(&restoringBranchResp, nil).Times(1),
+		s.EXPECT().GetBranch(gomock.Any(), clusterId, branchId, branchV1beta1.BRANCHSERVICEGETBRANCHVIEWPARAMETER_BASIC).Return(&activeBranchResp, nil).MinTimes(1),
+	)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		PreCheck:   func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUTServerlessBranchResetActionConfig,
+			},
+		},
+	})
+}
+
+const testUTServerlessBranchResetActionConfig = `
+action "tidbcloud_serverless_branch_reset" "test" {
+  config {
+    cluster_id = "clusterId"
+    branch_id  = "branchId"
+  }
+}
+
+resource "terraform_data" "test" {
+  input = "reset"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.tidbcloud_serverless_branch_reset.test]
+    }
+  }
+}
+`
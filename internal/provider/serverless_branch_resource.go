@@ -4,11 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
@@ -42,6 +42,11 @@ type serverlessBranchResourceData struct {
 	Annotations       types.Map    `tfsdk:"annotations"`
 }
 
+type serverlessBranchResourceIdentity struct {
+	ClusterId types.String `tfsdk:"cluster_id"`
+	BranchId  types.String `tfsdk:"branch_id"`
+}
+
 type serverlessBranchResource struct {
 	provider *tidbcloudProvider
 }
@@ -217,6 +222,21 @@ func (r *serverlessBranchResource) Schema(_ context.Context, _ resource.SchemaRe
 	}
 }
 
+func (r *serverlessBranchResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"cluster_id": identityschema.StringAttribute{
+				Description:       "The ID of the cluster.",
+				RequiredForImport: true,
+			},
+			"branch_id": identityschema.StringAttribute{
+				Description:       "The ID of the branch.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r serverlessBranchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if !r.provider.configured {
 		resp.Diagnostics.AddError(
@@ -271,6 +291,7 @@ func (r serverlessBranchResource) Create(ctx context.Context, req resource.Creat
 	// save into the Terraform state.
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, serverlessBranchResourceIdentity{ClusterId: data.ClusterId, BranchId: data.BranchId})...)
 }
 
 func (r serverlessBranchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -299,6 +320,7 @@ func (r serverlessBranchResource) Read(ctx context.Context, req resource.ReadReq
 	// save into the Terraform state.
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, serverlessBranchResourceIdentity{ClusterId: data.ClusterId, BranchId: data.BranchId})...)
 }
 
 func (r serverlessBranchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -323,18 +345,31 @@ func (r serverlessBranchResource) Update(ctx context.Context, req resource.Updat
 }
 
 func (r serverlessBranchResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: cluster_id, branch_id. Got: %q", req.ID),
-		)
+	clusterId, displayName, byName, err := parseImportByName(req.ID, "cluster_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+	if !byName {
+		importStateByFields(ctx, "tidbcloud_serverless_branch", req, resp)
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("branch_id"), idParts[1])...)
+	branches, err := serverlessBranchesDataSource{provider: r.provider}.retrieveBranches(ctx, clusterId)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to call ListBranches, got error: %s", err))
+		return
+	}
+	branchId, err := resolveDisplayName(branches, displayName, "branch", "cluster "+clusterId,
+		func(b branchV1beta1.Branch) (string, string) {
+			return b.DisplayName, *b.BranchId
+		})
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), clusterId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("branch_id"), branchId)...)
 }
 
 func buildCreateServerlessBranchBody(data serverlessBranchResourceData) (branchV1beta1.Branch, error) {
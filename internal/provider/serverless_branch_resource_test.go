@@ -2,10 +2,12 @@ package provider
 
 import (
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	mockClient "github.com/tidbcloud/terraform-provider-tidbcloud/mock"
 	"github.com/tidbcloud/terraform-provider-tidbcloud/tidbcloud"
 	branchV1beta1 "github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/serverless/branch"
@@ -33,7 +35,7 @@ func TestUTServerlessBranchResource(t *testing.T) {
 
 	ctrl := gomock.NewController(t)
 	s := mockClient.NewMockTiDBCloudServerlessClient(ctrl)
-	defer HookGlobal(&NewServerlessClient, func(publicKey string, privateKey string, serverlessEndpoint string, userAgent string) (tidbcloud.TiDBCloudServerlessClient, error) {
+	defer HookGlobal(&NewServerlessClient, func(publicKey string, privateKey string, serverlessEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudServerlessClient, error) {
 		return s, nil
 	})()
 
@@ -54,6 +56,49 @@ func TestUTServerlessBranchResource(t *testing.T) {
 	testServerlessBranchResource(t)
 }
 
+func TestUTServerlessBranchResourceImportByIdentity(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudServerlessClient(ctrl)
+	defer HookGlobal(&NewServerlessClient, func(publicKey string, privateKey string, serverlessEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudServerlessClient, error) {
+		return s, nil
+	})()
+
+	branchId := "branchId"
+
+	createBranchResp := branchV1beta1.Branch{}
+	createBranchResp.UnmarshalJSON([]byte(testUTBranch(string(branchV1beta1.BRANCHSTATE_CREATING))))
+	getBranchResp := branchV1beta1.Branch{}
+	getBranchResp.UnmarshalJSON([]byte(testUTBranch(string(branchV1beta1.BRANCHSTATE_ACTIVE))))
+	getBranchFullResp := branchV1beta1.Branch{}
+	getBranchFullResp.UnmarshalJSON([]byte(testUTBranchFull(string(branchV1beta1.BRANCHSTATE_ACTIVE))))
+
+	s.EXPECT().CreateBranch(gomock.Any(), gomock.Any(), gomock.Any()).Return(&createBranchResp, nil)
+	s.EXPECT().GetBranch(gomock.Any(), "clusterId", branchId, branchV1beta1.BRANCHSERVICEGETBRANCHVIEWPARAMETER_BASIC).Return(&getBranchResp, nil).AnyTimes()
+	s.EXPECT().GetBranch(gomock.Any(), "clusterId", branchId, branchV1beta1.BRANCHSERVICEGETBRANCHVIEWPARAMETER_FULL).Return(&getBranchFullResp, nil).AnyTimes()
+	s.EXPECT().DeleteBranch(gomock.Any(), "clusterId", branchId).Return(nil, nil)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		PreCheck:   func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUTServerlessBranchResourceConfig(),
+			},
+			{
+				ResourceName:    "tidbcloud_serverless_branch.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
 func testServerlessBranchResource(t *testing.T) {
 	serverlessBranchResourceName := "tidbcloud_serverless_branch.test"
 	resource.Test(t, resource.TestCase{
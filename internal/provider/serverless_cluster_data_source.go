@@ -13,6 +13,7 @@ import (
 )
 
 type serverlessCluster struct {
+	ProjectId             types.String           `tfsdk:"project_id"`
 	ClusterId             types.String           `tfsdk:"cluster_id"`
 	DisplayName           types.String           `tfsdk:"display_name"`
 	Region                *region                `tfsdk:"region"`
@@ -32,6 +33,7 @@ type serverlessCluster struct {
 }
 
 var _ datasource.DataSource = &serverlessClusterDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &serverlessClusterDataSource{}
 
 type serverlessClusterDataSource struct {
 	provider *tidbcloudProvider
@@ -60,12 +62,19 @@ func (d *serverlessClusterDataSource) Schema(_ context.Context, _ datasource.Sch
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "serverless cluster data source",
 		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project. Used to scope the lookup by display_name. If not set, the clusters of all the projects are searched.",
+				Optional:            true,
+				Computed:            true,
+			},
 			"cluster_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the cluster.",
-				Required:            true,
+				MarkdownDescription: "The ID of the cluster. Exactly one of cluster_id and display_name must be set.",
+				Optional:            true,
+				Computed:            true,
 			},
 			"display_name": schema.StringAttribute{
-				MarkdownDescription: "The display name of the cluster.",
+				MarkdownDescription: "The display name of the cluster. Exactly one of cluster_id and display_name must be set. An error is returned if no cluster or more than one cluster has the display name.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"region": schema.SingleNestedAttribute{
@@ -229,6 +238,12 @@ func (d *serverlessClusterDataSource) Schema(_ context.Context, _ datasource.Sch
 	}
 }
 
+func (d *serverlessClusterDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		exactlyOneOf("cluster_id", "display_name"),
+	}
+}
+
 func (d *serverlessClusterDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data serverlessCluster
 	diags := req.Config.Get(ctx, &data)
@@ -238,7 +253,24 @@ func (d *serverlessClusterDataSource) Read(ctx context.Context, req datasource.R
 	}
 
 	tflog.Trace(ctx, "read serverless cluster data source")
-	cluster, err := d.provider.ServerlessClient.GetCluster(ctx, data.ClusterId.ValueString(), clusterV1beta1.CLUSTERSERVICEGETCLUSTERVIEWPARAMETER_FULL)
+	clusterId := data.ClusterId.ValueString()
+	if IsKnown(data.DisplayName) {
+		projectId := data.ProjectId.ValueString()
+		clusters, err := (&serverlessClustersDataSource{provider: d.provider}).retrieveClusters(ctx, projectId)
+		if err != nil {
+			resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to call ListClusters, got error: %s", err))
+			return
+		}
+		clusterId, err = resolveDisplayName(clusters, data.DisplayName.ValueString(), "serverless cluster", projectScope(projectId),
+			func(c clusterV1beta1.TidbCloudOpenApiserverlessv1beta1Cluster) (string, string) {
+				return c.DisplayName, *c.ClusterId
+			})
+		if err != nil {
+			resp.Diagnostics.AddError("Read Error", err.Error())
+			return
+		}
+	}
+	cluster, err := d.provider.ServerlessClient.GetCluster(ctx, clusterId, clusterV1beta1.CLUSTERSERVICEGETCLUSTERVIEWPARAMETER_FULL)
 	if err != nil {
 		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to call GetCluster, got error: %s", err))
 		return
@@ -254,6 +286,7 @@ func (d *serverlessClusterDataSource) Read(ctx context.Context, req datasource.R
 		diags.AddError("Read Error", "unable to convert annotations")
 		return
 	}
+	data.ProjectId = types.StringValue((*cluster.Labels)[LabelsKeyProjectId])
 	data.ClusterId = types.StringValue(*cluster.ClusterId)
 	data.DisplayName = types.StringValue(cluster.DisplayName)
 
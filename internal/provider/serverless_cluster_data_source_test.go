@@ -2,6 +2,8 @@ package provider
 
 import (
 	"fmt"
+	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -35,7 +37,7 @@ func TestUTServerlessClusterDataSource(t *testing.T) {
 
 	ctrl := gomock.NewController(t)
 	s := mockClient.NewMockTiDBCloudServerlessClient(ctrl)
-	defer HookGlobal(&NewServerlessClient, func(publicKey string, privateKey string, serverlessEndpoint string, userAgent string) (tidbcloud.TiDBCloudServerlessClient, error) {
+	defer HookGlobal(&NewServerlessClient, func(publicKey string, privateKey string, serverlessEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudServerlessClient, error) {
 		return s, nil
 	})()
 
@@ -90,3 +92,57 @@ data "tidbcloud_serverless_cluster" "test" {
 }
 `, cluster_id)
 }
+
+func TestUTServerlessClusterDataSourceByDisplayName(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudServerlessClient(ctrl)
+	defer HookGlobal(&NewServerlessClient, func(publicKey string, privateKey string, serverlessEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudServerlessClient, error) {
+		return s, nil
+	})()
+
+	state := string(clusterV1beta1.COMMONV1BETA1CLUSTERSTATE_ACTIVE)
+	var clusters []clusterV1beta1.TidbCloudOpenApiserverlessv1beta1Cluster
+	for _, c := range []struct{ id, name string }{{"cluster_id", "test-tf"}, {"dup_1", "dup"}, {"dup_2", "dup"}} {
+		cluster := clusterV1beta1.TidbCloudOpenApiserverlessv1beta1Cluster{}
+		cluster.UnmarshalJSON([]byte(testUTTidbCloudOpenApiserverlessv1beta1Cluster(c.id, "regions/aws-us-east-1", c.name, state)))
+		clusters = append(clusters, cluster)
+	}
+	listClustersResp := clusterV1beta1.TidbCloudOpenApiserverlessv1beta1ListClustersResponse{Clusters: clusters}
+
+	s.EXPECT().ListClusters(gomock.Any(), gomock.Any(), gomock.Any(), nil, nil, nil).Return(&listClustersResp, nil).AnyTimes()
+	s.EXPECT().GetCluster(gomock.Any(), "cluster_id", clusterV1beta1.CLUSTERSERVICEGETCLUSTERVIEWPARAMETER_FULL).Return(&clusters[0], nil).AnyTimes()
+
+	serverlessClusterDataSourceName := "data.tidbcloud_serverless_cluster.test"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUTServerlessClusterDataSourceByDisplayNameConfig("test-tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(serverlessClusterDataSourceName, "cluster_id", "cluster_id"),
+					resource.TestCheckResourceAttr(serverlessClusterDataSourceName, "display_name", "test-tf"),
+				),
+			},
+			{
+				Config:      testUTServerlessClusterDataSourceByDisplayNameConfig("not-exist"),
+				ExpectError: regexp.MustCompile(`no serverless cluster named "not-exist" found in any project`),
+			},
+			{
+				Config:      testUTServerlessClusterDataSourceByDisplayNameConfig("dup"),
+				ExpectError: regexp.MustCompile(`2 serverless clusters named "dup" found in any project: dup_1, dup_2`),
+			},
+		},
+	})
+}
+
+func testUTServerlessClusterDataSourceByDisplayNameConfig(displayName string) string {
+	return fmt.Sprintf(`
+data "tidbcloud_serverless_cluster" "test" {
+	display_name = "%s"
+}
+`, displayName)
+}
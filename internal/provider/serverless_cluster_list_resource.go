@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	clusterV1beta1 "github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/serverless/cluster"
+)
+
+type serverlessClusterListConfig struct {
+	ProjectId types.String `tfsdk:"project_id"`
+}
+
+type serverlessClusterListResource struct {
+	provider *tidbcloudProvider
+}
+
+var _ list.ListResourceWithConfigure = &serverlessClusterListResource{}
+
+func NewServerlessClusterListResource() list.ListResource {
+	return &serverlessClusterListResource{}
+}
+
+func (r *serverlessClusterListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_serverless_cluster"
+}
+
+func (r *serverlessClusterListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	var ok bool
+	if r.provider, ok = req.ProviderData.(*tidbcloudProvider); !ok {
+		resp.Diagnostics.AddError("Internal provider error",
+			fmt.Sprintf("Error in Configure: expected %T but got %T", tidbcloudProvider{}, req.ProviderData))
+	}
+}
+
+func (r *serverlessClusterListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the serverless clusters.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project. If not set, the clusters of all the projects are listed.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *serverlessClusterListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config serverlessClusterListConfig
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Trace(ctx, "list serverless_cluster_resource")
+	clusters, err := (&serverlessClustersDataSource{provider: r.provider}).retrieveClusters(ctx, config.ProjectId.ValueString())
+	if err != nil {
+		stream.Results = listError("List Error", fmt.Sprintf("Unable to call ListClusters, got error: %s", err))
+		return
+	}
+
+	stream.Results = listResults(ctx, req, clusters, func(cluster clusterV1beta1.TidbCloudOpenApiserverlessv1beta1Cluster, result *list.ListResult) {
+		result.DisplayName = cluster.DisplayName
+		result.Diagnostics.Append(result.Identity.Set(ctx, serverlessClusterResourceIdentity{ClusterId: types.StringValue(*cluster.ClusterId)})...)
+		if !req.IncludeResource {
+			return
+		}
+		var data serverlessClusterResourceData
+		if err := refreshServerlessClusterResourceData(ctx, &cluster, &data); err != nil {
+			result.Diagnostics.AddError("List Error", fmt.Sprintf("Unable to refresh serverless cluster resource data, got error: %s", err))
+			return
+		}
+		result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+	})
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	mockClient "github.com/tidbcloud/terraform-provider-tidbcloud/mock"
+	"github.com/tidbcloud/terraform-provider-tidbcloud/tidbcloud"
+	clusterV1beta1 "github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/serverless/cluster"
+)
+
+func TestUTServerlessClusterListResource(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudServerlessClient(ctrl)
+	defer HookGlobal(&NewServerlessClient, func(publicKey string, privateKey string, serverlessEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudServerlessClient, error) {
+		return s, nil
+	})()
+
+	resp := clusterV1beta1.TidbCloudOpenApiserverlessv1beta1ListClustersResponse{}
+	resp.UnmarshalJSON([]byte(testUTTidbCloudOpenApiserverlessv1beta1ListClustersResponse))
+
+	s.EXPECT().ListClusters(gomock.Any(), gomock.Any(), gomock.Any(), nil, nil, nil).
+		Return(&resp, nil).AnyTimes()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		PreCheck:   func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUTServerlessClustersConfig,
+			},
+			{
+				Query: true,
+				Config: `
+provider "tidbcloud" {}
+
+list "tidbcloud_serverless_cluster" "test" {
+  provider = tidbcloud
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("tidbcloud_serverless_cluster.test", 1),
+					querycheck.ExpectIdentity("tidbcloud_serverless_cluster.test", map[string]knownvalue.Check{
+						"cluster_id": knownvalue.StringExact("xxxxxxxxxxx"),
+					}),
+				},
+			},
+		},
+	})
+}
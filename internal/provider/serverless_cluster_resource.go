@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
@@ -119,6 +120,18 @@ type encryptionConfig struct {
 	EnhancedEncryptionEnabled types.Bool `tfsdk:"enhanced_encryption_enabled"`
 }
 
+type serverlessClusterResourceIdentity struct {
+	ClusterId types.String `tfsdk:"cluster_id"`
+}
+
+// serverlessClusterStateUpgrades rewrites the state of each prior schema version to the next one.
+var serverlessClusterStateUpgrades = []rawStateUpgrade{
+	// 0 => 1: the first versioned schema, the state layout is unchanged.
+	nil,
+}
+
+var _ resource.ResourceWithUpgradeState = &serverlessClusterResource{}
+
 type serverlessClusterResource struct {
 	provider *tidbcloudProvider
 }
@@ -147,6 +160,7 @@ func (r *serverlessClusterResource) Configure(_ context.Context, req resource.Co
 func (r *serverlessClusterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "serverless cluster resource",
+		Version:             int64(len(serverlessClusterStateUpgrades)),
 		Attributes: map[string]schema.Attribute{
 			"project_id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the project. When not provided, the default project will be used.",
@@ -393,6 +407,21 @@ func (r *serverlessClusterResource) Schema(_ context.Context, _ resource.SchemaR
 	}
 }
 
+func (r *serverlessClusterResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(serverlessClusterStateUpgrades)
+}
+
+func (r *serverlessClusterResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"cluster_id": identityschema.StringAttribute{
+				Description:       "The ID of the cluster.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r serverlessClusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if !r.provider.configured {
 		resp.Diagnostics.AddError(
@@ -447,6 +476,7 @@ func (r serverlessClusterResource) Create(ctx context.Context, req resource.Crea
 	// save into the Terraform state.
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, serverlessClusterResourceIdentity{ClusterId: data.ClusterId})...)
 }
 
 func (r serverlessClusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -476,6 +506,7 @@ func (r serverlessClusterResource) Read(ctx context.Context, req resource.ReadRe
 	// save into the Terraform state.
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, serverlessClusterResourceIdentity{ClusterId: data.ClusterId})...)
 }
 
 func (r serverlessClusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -654,7 +685,30 @@ func (r serverlessClusterResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r serverlessClusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("cluster_id"), req, resp)
+	projectId, displayName, byName, err := parseImportByName(req.ID, "project_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+	if !byName {
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("cluster_id"), path.Root("cluster_id"), req, resp)
+		return
+	}
+
+	clusters, err := (&serverlessClustersDataSource{provider: r.provider}).retrieveClusters(ctx, projectId)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to call ListClusters, got error: %s", err))
+		return
+	}
+	clusterId, err := resolveDisplayName(clusters, displayName, "serverless cluster", "project "+projectId,
+		func(c clusterV1beta1.TidbCloudOpenApiserverlessv1beta1Cluster) (string, string) {
+			return c.DisplayName, *c.ClusterId
+		})
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), clusterId)...)
 }
 
 func buildCreateServerlessClusterBody(ctx context.Context, data serverlessClusterResourceData) (clusterV1beta1.TidbCloudOpenApiserverlessv1beta1Cluster, error) {
@@ -4,11 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -95,6 +95,19 @@ type azureBlobTarget struct {
 	AuthType types.String `tfsdk:"auth_type"`
 	SasToken types.String `tfsdk:"sas_token"`
 }
+type serverlessExportResourceIdentity struct {
+	ClusterId types.String `tfsdk:"cluster_id"`
+	ExportId  types.String `tfsdk:"export_id"`
+}
+
+// serverlessExportStateUpgrades rewrites the state of each prior schema version to the next one.
+var serverlessExportStateUpgrades = []rawStateUpgrade{
+	// 0 => 1: the first versioned schema, the state layout is unchanged.
+	nil,
+}
+
+var _ resource.ResourceWithUpgradeState = &serverlessExportResource{}
+
 type serverlessExportResource struct {
 	provider *tidbcloudProvider
 }
@@ -123,6 +136,7 @@ func (r *serverlessExportResource) Configure(_ context.Context, req resource.Con
 func (r *serverlessExportResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Serverless Export Resource",
+		Version:             int64(len(serverlessExportStateUpgrades)),
 		Attributes: map[string]schema.Attribute{
 			"export_id": schema.StringAttribute{
 				MarkdownDescription: "The unique ID of the export.",
@@ -419,6 +433,25 @@ func (r *serverlessExportResource) Schema(_ context.Context, _ resource.SchemaRe
 	}
 }
 
+func (r *serverlessExportResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(serverlessExportStateUpgrades)
+}
+
+func (r *serverlessExportResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"cluster_id": identityschema.StringAttribute{
+				Description:       "The ID of the cluster.",
+				RequiredForImport: true,
+			},
+			"export_id": identityschema.StringAttribute{
+				Description:       "The ID of the export.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *serverlessExportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if !r.provider.configured {
 		resp.Diagnostics.AddError(
@@ -456,6 +489,7 @@ func (r *serverlessExportResource) Create(ctx context.Context, req resource.Crea
 	// save to terraform state
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, serverlessExportResourceIdentity{ClusterId: data.ClusterId, ExportId: data.ExportId})...)
 }
 
 func (r *serverlessExportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -476,6 +510,7 @@ func (r *serverlessExportResource) Read(ctx context.Context, req resource.ReadRe
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, serverlessExportResourceIdentity{ClusterId: data.ClusterId, ExportId: data.ExportId})...)
 }
 
 func (r *serverlessExportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -514,18 +549,7 @@ func (r *serverlessExportResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *serverlessExportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: cluster_id, export_id. Got: %q", req.ID),
-		)
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("export_id"), idParts[1])...)
+	importStateByFields(ctx, "tidbcloud_serverless_export", req, resp)
 }
 
 func buildCreateServerlessExportBody(ctx context.Context, data serverlessExportResourceData) (exportV1beta1.ExportServiceCreateExportBody, error) {
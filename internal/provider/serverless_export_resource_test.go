@@ -2,10 +2,12 @@ package provider
 
 import (
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	mockClient "github.com/tidbcloud/terraform-provider-tidbcloud/mock"
 	"github.com/tidbcloud/terraform-provider-tidbcloud/tidbcloud"
 	exportV1beta1 "github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/serverless/export"
@@ -34,7 +36,7 @@ func TestUTServerlessExportResource(t *testing.T) {
 
 	ctrl := gomock.NewController(t)
 	s := mockClient.NewMockTiDBCloudServerlessClient(ctrl)
-	defer HookGlobal(&NewServerlessClient, func(publicKey string, privateKey string, serverlessEndpoint string, userAgent string) (tidbcloud.TiDBCloudServerlessClient, error) {
+	defer HookGlobal(&NewServerlessClient, func(publicKey string, privateKey string, serverlessEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudServerlessClient, error) {
 		return s, nil
 	})()
 
@@ -52,6 +54,46 @@ func TestUTServerlessExportResource(t *testing.T) {
 	testServerlessExportResource(t)
 }
 
+func TestUTServerlessExportResourceImportByIdentity(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudServerlessClient(ctrl)
+	defer HookGlobal(&NewServerlessClient, func(publicKey string, privateKey string, serverlessEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudServerlessClient, error) {
+		return s, nil
+	})()
+
+	exportId := "export-id"
+
+	createExportResp := exportV1beta1.Export{}
+	createExportResp.UnmarshalJSON([]byte(testUTExport(string(exportV1beta1.EXPORTSTATEENUM_RUNNING))))
+	getExportResp := exportV1beta1.Export{}
+	getExportResp.UnmarshalJSON([]byte(testUTExport(string(exportV1beta1.EXPORTSTATEENUM_SUCCEEDED))))
+
+	s.EXPECT().CreateExport(gomock.Any(), gomock.Any(), gomock.Any()).Return(&createExportResp, nil)
+	s.EXPECT().GetExport(gomock.Any(), gomock.Any(), exportId).Return(&getExportResp, nil).AnyTimes()
+	s.EXPECT().DeleteExport(gomock.Any(), gomock.Any(), exportId).Return(nil, nil)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		PreCheck:   func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUTServerlessExportResourceConfig(),
+			},
+			{
+				ResourceName:    "tidbcloud_serverless_export.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
 func testServerlessExportResource(t *testing.T) {
 	serverlessExportResourceName := "tidbcloud_serverless_export.test"
 	resource.Test(t, resource.TestCase{
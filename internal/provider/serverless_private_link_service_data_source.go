@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	clusterV1beta1 "github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/serverless/cluster"
+)
+
+type serverlessPrivateLinkServiceDataSourceData struct {
+	ClusterId     types.String                       `tfsdk:"cluster_id"`
+	CloudProvider types.String                       `tfsdk:"cloud_provider"`
+	RegionId      types.String                       `tfsdk:"region_id"`
+	Host          types.String                       `tfsdk:"host"`
+	Port          types.Int32                        `tfsdk:"port"`
+	AWS           *serverlessPrivateLinkServiceAWS   `tfsdk:"aws"`
+	GCP           *serverlessPrivateLinkServiceGCP   `tfsdk:"gcp"`
+	Azure         *serverlessPrivateLinkServiceAzure `tfsdk:"azure"`
+}
+
+type serverlessPrivateLinkServiceAWS struct {
+	ServiceName       types.String `tfsdk:"service_name"`
+	AvailabilityZones types.List   `tfsdk:"availability_zones"`
+}
+
+type serverlessPrivateLinkServiceGCP struct {
+	ServiceAttachment types.String `tfsdk:"service_attachment"`
+}
+
+type serverlessPrivateLinkServiceAzure struct {
+	Alias types.String `tfsdk:"alias"`
+}
+
+var _ datasource.DataSource = &serverlessPrivateLinkServiceDataSource{}
+
+type serverlessPrivateLinkServiceDataSource struct {
+	provider *tidbcloudProvider
+}
+
+func NewServerlessPrivateLinkServiceDataSource() datasource.DataSource {
+	return &serverlessPrivateLinkServiceDataSource{}
+}
+
+func (d *serverlessPrivateLinkServiceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_serverless_private_link_service"
+}
+
+func (d *serverlessPrivateLinkServiceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	var ok bool
+	if d.provider, ok = req.ProviderData.(*tidbcloudProvider); !ok {
+		resp.Diagnostics.AddError("Internal provider error",
+			fmt.Sprintf("Error in Configure: expected %T but got %T", tidbcloudProvider{}, req.ProviderData))
+	}
+}
+
+func (d *serverlessPrivateLinkServiceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "serverless private link service data source. Only the block of the cloud provider of the cluster is set.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the cluster.",
+				Required:            true,
+			},
+			"cloud_provider": schema.StringAttribute{
+				MarkdownDescription: "The cloud provider of the cluster, one of aws, gcp and azure.",
+				Computed:            true,
+			},
+			"region_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the region of the cluster.",
+				Computed:            true,
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "The host of the private endpoint.",
+				Computed:            true,
+			},
+			"port": schema.Int32Attribute{
+				MarkdownDescription: "The port of the private endpoint.",
+				Computed:            true,
+			},
+			"aws": schema.SingleNestedAttribute{
+				MarkdownDescription: "The AWS endpoint service. Use it to create an aws_vpc_endpoint.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"service_name": schema.StringAttribute{
+						MarkdownDescription: "The name of the AWS endpoint service.",
+						Computed:            true,
+					},
+					"availability_zones": schema.ListAttribute{
+						MarkdownDescription: "The availability zones where the endpoint service is available.",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
+			"gcp": schema.SingleNestedAttribute{
+				MarkdownDescription: "The GCP Private Service Connect service attachment. Use it as the target of a google_compute_forwarding_rule.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"service_attachment": schema.StringAttribute{
+						MarkdownDescription: "The name of the service attachment.",
+						Computed:            true,
+					},
+				},
+			},
+			"azure": schema.SingleNestedAttribute{
+				MarkdownDescription: "The Azure private link service. Use it to create an azurerm_private_endpoint.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"alias": schema.StringAttribute{
+						MarkdownDescription: "The alias of the private link service.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *serverlessPrivateLinkServiceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data serverlessPrivateLinkServiceDataSourceData
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read serverless private link service data source")
+	cluster, err := d.provider.ServerlessClient.GetCluster(ctx, data.ClusterId.ValueString(), clusterV1beta1.CLUSTERSERVICEGETCLUSTERVIEWPARAMETER_FULL)
+	if err != nil {
+		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to call GetCluster, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(refreshServerlessPrivateLinkServiceData(ctx, cluster, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func refreshServerlessPrivateLinkServiceData(ctx context.Context, cluster *clusterV1beta1.TidbCloudOpenApiserverlessv1beta1Cluster, data *serverlessPrivateLinkServiceDataSourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if r := cluster.Region; r != nil {
+		if r.CloudProvider != nil {
+			data.CloudProvider = types.StringValue(string(*r.CloudProvider))
+		}
+		data.RegionId = types.StringPointerValue(r.RegionId)
+	}
+
+	data.Host = types.StringNull()
+	data.Port = types.Int32Null()
+	data.AWS, data.GCP, data.Azure = nil, nil, nil
+	if cluster.Endpoints == nil || cluster.Endpoints.Private == nil {
+		return diags
+	}
+	p := cluster.Endpoints.Private
+	data.Host = types.StringPointerValue(p.Host)
+	data.Port = types.Int32PointerValue(p.Port)
+	if p.Aws != nil {
+		availabilityZones, listDiags := types.ListValueFrom(ctx, types.StringType, p.Aws.AvailabilityZone)
+		diags.Append(listDiags...)
+		data.AWS = &serverlessPrivateLinkServiceAWS{
+			ServiceName:       types.StringPointerValue(p.Aws.ServiceName),
+			AvailabilityZones: availabilityZones,
+		}
+	}
+	if p.Gcp != nil {
+		data.GCP = &serverlessPrivateLinkServiceGCP{
+			ServiceAttachment: types.StringPointerValue(p.Gcp.ServiceAttachmentName),
+		}
+	}
+	if p.Azure != nil {
+		data.Azure = &serverlessPrivateLinkServiceAzure{
+			Alias: types.StringPointerValue(p.Azure.Alias),
+		}
+	}
+	return diags
+}
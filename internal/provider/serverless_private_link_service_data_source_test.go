@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	mockClient "github.com/tidbcloud/terraform-provider-tidbcloud/mock"
+	"github.com/tidbcloud/terraform-provider-tidbcloud/tidbcloud"
+	clusterV1beta1 "github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/serverless/cluster"
+)
+
+func TestUTServerlessPrivateLinkServiceDataSource(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudServerlessClient(ctrl)
+	defer HookGlobal(&NewServerlessClient, func(publicKey string, privateKey string, serverlessEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudServerlessClient, error) {
+		return s, nil
+	})()
+
+	awsCluster := clusterV1beta1.TidbCloudOpenApiserverlessv1beta1Cluster{}
+	if err := awsCluster.UnmarshalJSON([]byte(testUTTidbCloudOpenApiserverlessv1beta1Cluster("aws_cluster_id", "regions/aws-us-east-1", "test-tf", string(clusterV1beta1.COMMONV1BETA1CLUSTERSTATE_ACTIVE)))); err != nil {
+		t.Fatalf("failed to unmarshal cluster response: %v", err)
+	}
+	gcpCluster := clusterV1beta1.TidbCloudOpenApiserverlessv1beta1Cluster{}
+	if err := gcpCluster.UnmarshalJSON([]byte(testUTServerlessGCPCluster("gcp_cluster_id"))); err != nil {
+		t.Fatalf("failed to unmarshal cluster response: %v", err)
+	}
+
+	s.EXPECT().GetCluster(gomock.Any(), "aws_cluster_id", clusterV1beta1.CLUSTERSERVICEGETCLUSTERVIEWPARAMETER_FULL).Return(&awsCluster, nil).AnyTimes()
+	s.EXPECT().GetCluster(gomock.Any(), "gcp_cluster_id", clusterV1beta1.CLUSTERSERVICEGETCLUSTERVIEWPARAMETER_FULL).Return(&gcpCluster, nil).AnyTimes()
+
+	testUTServerlessPrivateLinkServiceDataSource(t)
+}
+
+func testUTServerlessPrivateLinkServiceDataSource(t *testing.T) {
+	dataSourceName := "data.tidbcloud_serverless_private_link_service.test"
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUTServerlessPrivateLinkServiceDataSourceConfig("aws_cluster_id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cloud_provider", "aws"),
+					resource.TestCheckResourceAttr(dataSourceName, "host", "gateway01-privatelink.us-east-1.dev.shared.aws.tidbcloud.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "aws.service_name", "com.amazonaws.vpce.us-east-1.vpce-svc-03342995daxxxxxxx"),
+					resource.TestCheckResourceAttr(dataSourceName, "aws.availability_zones.#", "1"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "gcp"),
+				),
+			},
+			{
+				Config: testUTServerlessPrivateLinkServiceDataSourceConfig("gcp_cluster_id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cloud_provider", "gcp"),
+					resource.TestCheckResourceAttr(dataSourceName, "port", "4000"),
+					resource.TestCheckResourceAttr(dataSourceName, "gcp.service_attachment", "projects/tidbcloud/regions/us-central1/serviceAttachments/gateway-sa"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "aws"),
+				),
+			},
+		},
+	})
+}
+
+func testUTServerlessPrivateLinkServiceDataSourceConfig(clusterId string) string {
+	return `
+data "tidbcloud_serverless_private_link_service" "test" {
+  cluster_id = "` + clusterId + `"
+}
+`
+}
+
+// testUTServerlessGCPCluster returns the AWS cluster of testUTTidbCloudOpenApiserverlessv1beta1Cluster moved to GCP.
+func testUTServerlessGCPCluster(clusterId string) string {
+	cluster := testUTTidbCloudOpenApiserverlessv1beta1Cluster(clusterId, "regions/gcp-us-central1", "test-tf", string(clusterV1beta1.COMMONV1BETA1CLUSTERSTATE_ACTIVE))
+	return strings.NewReplacer(
+		`"cloudProvider": "aws"`, `"cloudProvider": "gcp"`,
+		`"regionId": "us-east-1"`, `"regionId": "us-central1"`,
+		`"aws": {
+                "serviceName": "com.amazonaws.vpce.us-east-1.vpce-svc-03342995daxxxxxxx",
+                "availabilityZone": [
+                    "use1-az1"
+                ]
+            }`, `"gcp": {
+                "serviceAttachmentName": "projects/tidbcloud/regions/us-central1/serviceAttachments/gateway-sa"
+            }`,
+	).Replace(cluster)
+}
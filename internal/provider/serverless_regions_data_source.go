@@ -87,7 +87,7 @@ func (d *ServerlessRegionsDataSource) Read(ctx context.Context, req datasource.R
 	}
 
 	tflog.Trace(ctx, "read serverless regions data source")
-	regions, err := d.provider.ServerlessClient.ListProviderRegions(ctx)
+	regions, err := d.provider.listServerlessRegions(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to call ListRegions, got error: %s", err))
 		return
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// maxSQLUserNameLength is the max length of a sql user name, including the user prefix.
+const maxSQLUserNameLength = 32
+
+type serverlessUsernameFunction struct{}
+
+var _ function.Function = &serverlessUsernameFunction{}
+
+func NewServerlessUsernameFunction() function.Function {
+	return &serverlessUsernameFunction{}
+}
+
+func (f *serverlessUsernameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "serverless_username"
+}
+
+func (f *serverlessUsernameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build the user name of a serverless cluster",
+		MarkdownDescription: "Returns the full user name `<prefix>.<name>` of a serverless cluster. A name which already starts with the prefix is returned as it is. The full user name can't be longer than 32 characters.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "prefix",
+				MarkdownDescription: "The user_prefix of the serverless cluster or branch.",
+			},
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The user name with or without the prefix.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *serverlessUsernameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var prefix, name string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &prefix, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	if prefix == "" || strings.Contains(prefix, ".") {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid user prefix %q", prefix))
+		return
+	}
+	userName, err := serverlessUsername(prefix, name)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, userName))
+}
+
+// serverlessUsername returns the user name with the user prefix of a serverless cluster.
+func serverlessUsername(prefix string, name string) (string, error) {
+	userName := name
+	if !strings.HasPrefix(name, prefix+".") {
+		userName = prefix + "." + name
+	}
+	if userName == prefix+"." {
+		return "", fmt.Errorf("the user name can't be empty")
+	}
+	if len(userName) > maxSQLUserNameLength {
+		return "", fmt.Errorf("the user name %q is longer than %d characters", userName, maxSQLUserNameLength)
+	}
+	return userName, nil
+}
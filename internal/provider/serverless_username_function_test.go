@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestUTServerlessUsernameFunction(t *testing.T) {
+	setupTestEnv()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "user" {
+  value = provider::tidbcloud::serverless_username("2vphu1xrFp7dPRo", "app")
+}
+output "prefixed_user" {
+  value = provider::tidbcloud::serverless_username("2vphu1xrFp7dPRo", "2vphu1xrFp7dPRo.app")
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("user", "2vphu1xrFp7dPRo.app"),
+					resource.TestCheckOutput("prefixed_user", "2vphu1xrFp7dPRo.app"),
+				),
+			},
+			{
+				Config: `
+output "user" {
+  value = provider::tidbcloud::serverless_username("2vphu1xrFp7dPRo", "a_very_long_user_name")
+}
+`,
+				ExpectError: regexp.MustCompile(`longer than 32 characters`),
+			},
+		},
+	})
+}
@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tidbcloud/terraform-provider-tidbcloud/tidbcloud"
+	"github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/iam"
+)
+
+const (
+	defaultSQLUserCredentialsPrefix = "tf_"
+	sqlUserCredentialsPrivateKey    = "sql_user"
+	// the random part of the user name is kept short, the user name of a serverless cluster
+	// is limited to 32 characters including the user prefix of the cluster.
+	sqlUserCredentialsSuffixLength = 8
+	sqlUserCredentialsPasswordLen  = 32
+)
+
+type sqlUserCredentialsData struct {
+	ClusterId      types.String `tfsdk:"cluster_id"`
+	EndpointType   types.String `tfsdk:"endpoint_type"`
+	UserNamePrefix types.String `tfsdk:"user_name_prefix"`
+	BuiltinRole    types.String `tfsdk:"builtin_role"`
+	CustomRoles    types.List   `tfsdk:"custom_roles"`
+	UserName       types.String `tfsdk:"user_name"`
+	Password       types.String `tfsdk:"password"`
+	Host           types.String `tfsdk:"host"`
+	Port           types.Int32  `tfsdk:"port"`
+}
+
+// sqlUserCredentialsPrivate is saved in the private data of the ephemeral resource, so the
+// user can be deleted in Close.
+type sqlUserCredentialsPrivate struct {
+	ClusterId string `json:"cluster_id"`
+	UserName  string `json:"user_name"`
+}
+
+type sqlUserCredentialsEphemeralResource struct {
+	provider *tidbcloudProvider
+}
+
+var _ ephemeral.EphemeralResourceWithConfigure = &sqlUserCredentialsEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &sqlUserCredentialsEphemeralResource{}
+
+func NewSQLUserCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &sqlUserCredentialsEphemeralResource{}
+}
+
+func (r *sqlUserCredentialsEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sql_user_credentials"
+}
+
+func (r *sqlUserCredentialsEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	var ok bool
+	if r.provider, ok = req.ProviderData.(*tidbcloudProvider); !ok {
+		resp.Diagnostics.AddError("Internal provider error",
+			fmt.Sprintf("Error in Configure: expected %T but got %T", tidbcloudProvider{}, req.ProviderData))
+	}
+}
+
+func (r *sqlUserCredentialsEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a temporary SQL user for the duration of a Terraform run. The user is deleted when Terraform no longer needs it, and its password is never saved in the state.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the serverless or dedicated cluster.",
+				Required:            true,
+			},
+			"endpoint_type": schema.StringAttribute{
+				MarkdownDescription: "The endpoint returned in host and port, available values [public, private_link, vpc_peering]. private is the former name of private_link. vpc_peering is only available for dedicated cluster. Default is public.",
+				Optional:            true,
+			},
+			"user_name_prefix": schema.StringAttribute{
+				MarkdownDescription: "The prefix of the generated user name, a random suffix is appended to it. Default is tf_. The user prefix of a serverless cluster is added before it.",
+				Optional:            true,
+			},
+			"builtin_role": schema.StringAttribute{
+				MarkdownDescription: "The built-in role of the sql user, available values [role_admin, role_readonly, role_readwrite]. The built-in role [role_readonly, role_readwrite] must start with user_prefix for serverless cluster",
+				Required:            true,
+			},
+			"custom_roles": schema.ListAttribute{
+				MarkdownDescription: "The custom roles of the user.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"user_name": schema.StringAttribute{
+				MarkdownDescription: "The full name of the generated user.",
+				Computed:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The generated password of the user.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "The host of the cluster endpoint.",
+				Computed:            true,
+			},
+			"port": schema.Int32Attribute{
+				MarkdownDescription: "The port of the cluster endpoint.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *sqlUserCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if !r.provider.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	var data sqlUserCredentialsData
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpointType := EndpointTypePublic
+	if IsKnown(data.EndpointType) {
+		endpointType = data.EndpointType.ValueString()
+	}
+	endpoint, err := resolveClusterEndpoint(ctx, r.provider, data.ClusterId.ValueString(), "", endpointType)
+	if err != nil {
+		resp.Diagnostics.AddError("Open Error", fmt.Sprintf("Unable to resolve the endpoint of cluster %s, got error: %s", data.ClusterId.ValueString(), err))
+		return
+	}
+
+	prefix := defaultSQLUserCredentialsPrefix
+	if IsKnown(data.UserNamePrefix) {
+		prefix = data.UserNamePrefix.ValueString()
+	}
+	userName := prefix + GenerateRandomString(sqlUserCredentialsSuffixLength)
+	password := GenerateRandomPassword(sqlUserCredentialsPasswordLen)
+	authMethod := MYSQLNATIVEPASSWORD
+	builtinRole := data.BuiltinRole.ValueString()
+	var customRoles []string
+	resp.Diagnostics.Append(data.CustomRoles.ElementsAs(ctx, &customRoles, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// let the server add the user prefix of serverless clusters
+	autoPrefix := true
+	body := iam.ApiCreateSqlUserReq{
+		UserName:    &userName,
+		AuthMethod:  &authMethod,
+		BuiltinRole: &builtinRole,
+		CustomRoles: customRoles,
+		Password:    &password,
+		AutoPrefix:  &autoPrefix,
+	}
+
+	tflog.Trace(ctx, "open sql_user_credentials_ephemeral_resource")
+	sqlUser, err := r.provider.IAMClient.CreateSQLUser(ctx, data.ClusterId.ValueString(), &body)
+	if err != nil {
+		resp.Diagnostics.AddError("Open Error", fmt.Sprintf("Unable to call CreateSQLUser, got error: %s", err))
+		return
+	}
+	if sqlUser.UserName != nil {
+		userName = *sqlUser.UserName
+	}
+
+	private, err := json.Marshal(sqlUserCredentialsPrivate{
+		ClusterId: data.ClusterId.ValueString(),
+		UserName:  userName,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Open Error", fmt.Sprintf("Unable to save the sql user, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, sqlUserCredentialsPrivateKey, private)...)
+
+	data.UserName = types.StringValue(userName)
+	data.Password = types.StringValue(password)
+	data.Host = types.StringValue(endpoint.Host)
+	data.Port = types.Int32Value(endpoint.Port)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *sqlUserCredentialsEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	raw, diags := req.Private.GetKey(ctx, sqlUserCredentialsPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || raw == nil {
+		return
+	}
+	var private sqlUserCredentialsPrivate
+	if err := json.Unmarshal(raw, &private); err != nil {
+		resp.Diagnostics.AddError("Close Error", fmt.Sprintf("Unable to read the sql user, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "close sql_user_credentials_ephemeral_resource")
+	_, err := r.provider.IAMClient.DeleteSQLUser(ctx, private.ClusterId, private.UserName)
+	if err != nil {
+		if tidbcloud.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("sql user %s not found, it has been deleted already", private.UserName))
+			return
+		}
+		resp.Diagnostics.AddError("Close Error", fmt.Sprintf("Unable to call DeleteSQLUser, got error: %s", err))
+	}
+}
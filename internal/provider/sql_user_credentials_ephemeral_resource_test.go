@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	mockClient "github.com/tidbcloud/terraform-provider-tidbcloud/mock"
+	"github.com/tidbcloud/terraform-provider-tidbcloud/tidbcloud"
+	"github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/iam"
+	clusterV1beta1 "github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/serverless/cluster"
+)
+
+func TestUTSQLUserCredentialsEphemeralResource(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudServerlessClient(ctrl)
+	i := mockClient.NewMockTiDBCloudIAMClient(ctrl)
+	defer HookGlobal(&NewServerlessClient, func(publicKey string, privateKey string, serverlessEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudServerlessClient, error) {
+		return s, nil
+	})()
+	defer HookGlobal(&NewIAMClient, func(publicKey string, privateKey string, iamEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudIAMClient, error) {
+		return i, nil
+	})()
+
+	clusterId := "cluster_id"
+	userPrefix := "prefix"
+	getClusterResp := clusterV1beta1.TidbCloudOpenApiserverlessv1beta1Cluster{}
+	getClusterResp.UnmarshalJSON([]byte(testUTTidbCloudOpenApiserverlessv1beta1Cluster(clusterId, "regions/aws-us-east-1", "test-tf", string(clusterV1beta1.COMMONV1BETA1CLUSTERSTATE_ACTIVE))))
+
+	var fullName string
+	s.EXPECT().GetCluster(gomock.Any(), clusterId, clusterV1beta1.CLUSTERSERVICEGETCLUSTERVIEWPARAMETER_FULL).Return(&getClusterResp, nil).AnyTimes()
+	i.EXPECT().CreateSQLUser(gomock.Any(), clusterId, gomock.Any()).DoAndReturn(
+		func(_ interface{}, _ string, body *iam.ApiCreateSqlUserReq) (*iam.ApiSqlUser, error) {
+			if !*body.AutoPrefix || len(*body.Password) != sqlUserCredentialsPasswordLen {
+				t.Errorf("unexpected create sql user body: %v", body)
+			}
+			fullName = fmt.Sprintf("%s.%s", userPrefix, *body.UserName)
+			return &iam.ApiSqlUser{UserName: &fullName, BuiltinRole: body.BuiltinRole, AuthMethod: body.AuthMethod}, nil
+		}).AnyTimes()
+	i.EXPECT().DeleteSQLUser(gomock.Any(), clusterId, gomock.Any()).DoAndReturn(
+		func(_ interface{}, _ string, userName string) (*iam.ApiBasicResp, error) {
+			if userName != fullName {
+				t.Errorf("expected to delete %s, got %s", fullName, userName)
+			}
+			return nil, nil
+		}).AnyTimes()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		PreCheck:   func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"tidbcloud": testAccProtoV6ProviderFactories["tidbcloud"],
+			"echo":      echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testUTSQLUserCredentialsEphemeralResourceConfig(clusterId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("echo.test", "data.user_name", regexp.MustCompile(`^prefix\.ci_[a-z]{8}$`)),
+					resource.TestCheckResourceAttr("echo.test", "data.host", "gateway01.us-east-1.dev.shared.aws.tidbcloud.com"),
+					resource.TestCheckResourceAttr("echo.test", "data.port", "4000"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.password"),
+				),
+			},
+		},
+	})
+}
+
+func testUTSQLUserCredentialsEphemeralResourceConfig(clusterId string) string {
+	return fmt.Sprintf(`
+ephemeral "tidbcloud_sql_user_credentials" "test" {
+  cluster_id       = "%s"
+  builtin_role     = "role_admin"
+  user_name_prefix = "ci_"
+}
+
+provider "echo" {
+  data = ephemeral.tidbcloud_sql_user_credentials.test
+}
+
+resource "echo" "test" {}
+`, clusterId)
+}
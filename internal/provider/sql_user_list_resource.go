@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/iam"
+)
+
+type sqlUserListConfig struct {
+	ClusterId types.String `tfsdk:"cluster_id"`
+}
+
+type sqlUserListResource struct {
+	provider *tidbcloudProvider
+}
+
+var _ list.ListResourceWithConfigure = &sqlUserListResource{}
+
+func NewSQLUserListResource() list.ListResource {
+	return &sqlUserListResource{}
+}
+
+func (r *sqlUserListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sql_user"
+}
+
+func (r *sqlUserListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	var ok bool
+	if r.provider, ok = req.ProviderData.(*tidbcloudProvider); !ok {
+		resp.Diagnostics.AddError("Internal provider error",
+			fmt.Sprintf("Error in Configure: expected %T but got %T", tidbcloudProvider{}, req.ProviderData))
+	}
+}
+
+func (r *sqlUserListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the sql users of a cluster.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the cluster.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *sqlUserListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config sqlUserListConfig
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Trace(ctx, "list sql_user_resource")
+	sqlUsers, err := (&sqlUsersDataSource{provider: r.provider}).RetrieveSQLUsers(ctx, config.ClusterId.ValueString())
+	if err != nil {
+		stream.Results = listError("List Error", fmt.Sprintf("Unable to call ListSQLUsers, got error: %s", err))
+		return
+	}
+
+	stream.Results = listResults(ctx, req, sqlUsers, func(sqlUser iam.ApiSqlUser, result *list.ListResult) {
+		result.DisplayName = *sqlUser.UserName
+		result.Diagnostics.Append(result.Identity.Set(ctx, sqlUserResourceIdentity{
+			ClusterId: config.ClusterId,
+			UserName:  types.StringValue(*sqlUser.UserName),
+		})...)
+		if !req.IncludeResource {
+			return
+		}
+		// the password can't be read, it is left empty
+		data := sqlUserResourceData{
+			ClusterId:   config.ClusterId,
+			UserName:    types.StringValue(*sqlUser.UserName),
+			AuthMethod:  types.StringValue(*sqlUser.AuthMethod),
+			BuiltinRole: types.StringValue(*sqlUser.BuiltinRole),
+		}
+		customRoles, diags := types.ListValueFrom(ctx, types.StringType, sqlUser.CustomRoles)
+		result.Diagnostics.Append(diags...)
+		data.CustomRoles = customRoles
+		result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+	})
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	mockClient "github.com/tidbcloud/terraform-provider-tidbcloud/mock"
+	"github.com/tidbcloud/terraform-provider-tidbcloud/tidbcloud"
+	"github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/iam"
+)
+
+func TestUTSQLUserListResource(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudIAMClient(ctrl)
+	defer HookGlobal(&NewIAMClient, func(publicKey string, privateKey string, iamEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudIAMClient, error) {
+		return s, nil
+	})()
+
+	clusterId := "cluster_id"
+
+	listUserResp := iam.ApiListSqlUsersRsp{}
+	listUserResp.UnmarshalJSON([]byte(testUTApiListSqlUsersResponse))
+
+	s.EXPECT().ListSQLUsers(gomock.Any(), clusterId, gomock.Any(), gomock.Any()).Return(&listUserResp, nil).AnyTimes()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		PreCheck:   func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUTSQLUsersDataSourceConfig,
+			},
+			{
+				Query: true,
+				Config: `
+provider "tidbcloud" {}
+
+list "tidbcloud_sql_user" "test" {
+  provider = tidbcloud
+
+  config {
+    cluster_id = "cluster_id"
+  }
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("tidbcloud_sql_user.test", 2),
+					querycheck.ExpectIdentity("tidbcloud_sql_user.test", map[string]knownvalue.Check{
+						"cluster_id": knownvalue.StringExact(clusterId),
+						"user_name":  knownvalue.StringExact("xxxxxxxxxxxxxxx.root"),
+					}),
+					querycheck.ExpectIdentity("tidbcloud_sql_user.test", map[string]knownvalue.Check{
+						"cluster_id": knownvalue.StringExact(clusterId),
+						"user_name":  knownvalue.StringExact("xxxxxxxxxxxxxxx.test"),
+					}),
+				},
+			},
+		},
+	})
+}
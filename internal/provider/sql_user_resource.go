@@ -4,10 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -30,6 +30,11 @@ type sqlUserResourceData struct {
 	Password    types.String `tfsdk:"password"`
 }
 
+type sqlUserResourceIdentity struct {
+	ClusterId types.String `tfsdk:"cluster_id"`
+	UserName  types.String `tfsdk:"user_name"`
+}
+
 type sqlUserResource struct {
 	provider *tidbcloudProvider
 }
@@ -102,6 +107,21 @@ func (r *sqlUserResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 	}
 }
 
+func (r *sqlUserResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"cluster_id": identityschema.StringAttribute{
+				Description:       "The ID of the cluster.",
+				RequiredForImport: true,
+			},
+			"user_name": identityschema.StringAttribute{
+				Description:       "The name of the user.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r sqlUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if !r.provider.configured {
 		resp.Diagnostics.AddError(
@@ -134,6 +154,7 @@ func (r sqlUserResource) Create(ctx context.Context, req resource.CreateRequest,
 	// save into the Terraform state.
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, sqlUserResourceIdentity{ClusterId: data.ClusterId, UserName: data.UserName})...)
 }
 
 func (r sqlUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -164,6 +185,7 @@ func (r sqlUserResource) Read(ctx context.Context, req resource.ReadRequest, res
 	// save into the Terraform state.
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, sqlUserResourceIdentity{ClusterId: data.ClusterId, UserName: data.UserName})...)
 }
 
 func (r sqlUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -231,18 +253,7 @@ func (r sqlUserResource) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r sqlUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: cluster_id, user_name. Got: %q", req.ID),
-		)
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_name"), idParts[1])...)
+	importStateByFields(ctx, "tidbcloud_sql_user", req, resp)
 }
 
 func buildCreateSQLUserBody(ctx context.Context, data sqlUserResourceData) (iam.ApiCreateSqlUserReq, error) {
@@ -3,10 +3,12 @@ package provider
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	mockClient "github.com/tidbcloud/terraform-provider-tidbcloud/mock"
 	"github.com/tidbcloud/terraform-provider-tidbcloud/tidbcloud"
 	"github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/iam"
@@ -42,7 +44,7 @@ func TestUTSQLUserResource(t *testing.T) {
 
 	ctrl := gomock.NewController(t)
 	s := mockClient.NewMockTiDBCloudIAMClient(ctrl)
-	defer HookGlobal(&NewIAMClient, func(publicKey string, privateKey string, iamEndpoint string, userAgent string) (tidbcloud.TiDBCloudIAMClient, error) {
+	defer HookGlobal(&NewIAMClient, func(publicKey string, privateKey string, iamEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudIAMClient, error) {
 		return s, nil
 	})()
 
@@ -74,6 +76,50 @@ func TestUTSQLUserResource(t *testing.T) {
 	testSQLUserResource(t, clusterId, fullName, password, builtinRole, customRolesStr)
 }
 
+func TestUTSQLUserResourceImportByIdentity(t *testing.T) {
+	setupTestEnv()
+
+	ctrl := gomock.NewController(t)
+	s := mockClient.NewMockTiDBCloudIAMClient(ctrl)
+	defer HookGlobal(&NewIAMClient, func(publicKey string, privateKey string, iamEndpoint string, userAgent string, baseTransport http.RoundTripper) (tidbcloud.TiDBCloudIAMClient, error) {
+		return s, nil
+	})()
+
+	clusterId := "cluster_id"
+	userName := "test"
+	userPrefix := "prefix"
+	fullName := fmt.Sprintf("%s.%s", userPrefix, userName)
+	builtinRole := "role_admin"
+
+	getUserResp := iam.ApiSqlUser{}
+	getUserResp.UnmarshalJSON([]byte(testUTApiSqlUser(userName, userPrefix, builtinRole, "")))
+
+	s.EXPECT().CreateSQLUser(gomock.Any(), clusterId, gomock.Any()).Return(&getUserResp, nil)
+	s.EXPECT().GetSQLUser(gomock.Any(), clusterId, fullName).Return(&getUserResp, nil).AnyTimes()
+	s.EXPECT().DeleteSQLUser(gomock.Any(), clusterId, fullName).Return(nil, nil)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		PreCheck:   func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUTSQLUserResourceConfig(clusterId, fullName, "123456", builtinRole),
+			},
+			{
+				// the password is not returned by the API
+				ResourceName:       "tidbcloud_sql_user.test",
+				ImportState:        true,
+				ImportStateKind:    resource.ImportBlockWithResourceIdentity,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func testSQLUserResource(t *testing.T, clusterId, userName, password, builtinRole, customRoles string) {
 	sqlUserResourceName := "tidbcloud_sql_user.test"
 	resource.Test(t, resource.TestCase{
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// rawStateUpgrade rewrites the raw JSON state of a resource from one schema version to the next.
+// A nil rawStateUpgrade means both versions share the same state layout.
+type rawStateUpgrade func(state map[string]any) error
+
+// stateUpgraders returns the state upgraders of a resource whose schema version is len(upgrades).
+// upgrades[i] rewrites the state of version i to version i+1, so the upgrader of version i applies
+// upgrades[i:] in order. A resource appends an upgrade, which is nil if the layout of its state
+// is unchanged, whenever it bumps its schema version.
+func stateUpgraders(upgrades []rawStateUpgrade) map[int64]resource.StateUpgrader {
+	upgraders := make(map[int64]resource.StateUpgrader, len(upgrades))
+	for version := range upgrades {
+		pending := upgrades[version:]
+		upgraders[int64(version)] = resource.StateUpgrader{
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				state, err := upgradeRawState(req.RawState, resp.State.Schema.Type().TerraformType(ctx), pending)
+				if err != nil {
+					resp.Diagnostics.AddError("Unable to Upgrade Resource State",
+						fmt.Sprintf("Unable to upgrade the state from schema version %d, got error: %s", version, err))
+					return
+				}
+				resp.State.Raw = state
+			},
+		}
+	}
+	return upgraders
+}
+
+// upgradeRawState applies the upgrades to the raw JSON state and decodes the result with the
+// type of the current schema. Attributes which are no longer in the schema are dropped.
+func upgradeRawState(rawState *tfprotov6.RawState, schemaType tftypes.Type, upgrades []rawStateUpgrade) (tftypes.Value, error) {
+	if rawState == nil || rawState.JSON == nil {
+		return tftypes.Value{}, errors.New("the state is not stored as JSON")
+	}
+
+	var state map[string]any
+	if err := json.Unmarshal(rawState.JSON, &state); err != nil {
+		return tftypes.Value{}, err
+	}
+	for _, upgrade := range upgrades {
+		if upgrade == nil {
+			continue
+		}
+		if err := upgrade(state); err != nil {
+			return tftypes.Value{}, err
+		}
+	}
+	upgraded, err := json.Marshal(state)
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	return (&tfprotov6.RawState{JSON: upgraded}).UnmarshalWithOpts(schemaType, tfprotov6.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+			IgnoreUndefinedAttributes: true,
+		},
+	})
+}
@@ -0,0 +1,419 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// TestUTPriorState upgrades states saved by prior releases with the state upgraders of the resources,
+// so a schema change which breaks these states must come with a new schema version and a state upgrader.
+func TestUTPriorState(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name     string
+		resource resource.Resource
+		version  int64
+		state    string
+		// expected string attributes, keyed by dot separated attribute path
+		expected map[string]string
+	}{
+		{
+			name:     "serverless cluster v0",
+			resource: &serverlessClusterResource{},
+			version:  0,
+			state:    testUTServerlessClusterStateV0,
+			expected: map[string]string{
+				"cluster_id":                         "10000000000000000000",
+				"display_name":                       "test-tf",
+				"region.name":                        "regions/aws-us-east-1",
+				"endpoints.private.aws.service_name": "com.amazonaws.vpce.us-east-1.vpce-svc-00000000000000000",
+				"create_time":                        "2024-12-01T08:00:00Z",
+			},
+		},
+		{
+			name:     "dedicated cluster v0",
+			resource: &dedicatedClusterResource{},
+			version:  0,
+			state:    testUTDedicatedClusterStateV0,
+			expected: map[string]string{
+				"cluster_id":                        "10000000000000000001",
+				"tidb_node_setting.node_spec_key":   "8C16G",
+				"create_time":                       "2024-12-01 08:00:00 +0000 UTC",
+				"update_time":                       "2024-12-02 09:30:15 +0000 UTC",
+				"pause_plan.scheduled_resume_time":  "2024-12-03 00:00:00 +0000 UTC",
+				"tikv_node_setting.node_spec_key":   "8C32G",
+				"tidb_node_setting.node_group_id":   "1000000000",
+				"tiflash_node_setting.storage_type": "Standard",
+			},
+		},
+		{
+			name:     "serverless export v0",
+			resource: &serverlessExportResource{},
+			version:  0,
+			state:    testUTServerlessExportStateV0,
+			expected: map[string]string{
+				"export_id":                           "exp-00000000000000000000000000",
+				"export_options.file_type":            "CSV",
+				"target.s3.uri":                       "s3://bucket/path",
+				"target.s3.access_key.id":             "id",
+				"export_options.csv_format.separator": ",",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schemaResp := resource.SchemaResponse{}
+			tt.resource.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			rawState := &tfprotov6.RawState{JSON: []byte(tt.state)}
+
+			if tt.version >= schemaResp.Schema.Version {
+				t.Fatalf("expected a prior schema version, the current one is %d", schemaResp.Schema.Version)
+			}
+			r, ok := tt.resource.(resource.ResourceWithUpgradeState)
+			if !ok {
+				t.Fatalf("no state upgraders for schema version %d", schemaResp.Schema.Version)
+			}
+			upgrader, ok := r.UpgradeState(ctx)[tt.version]
+			if !ok {
+				t.Fatalf("no state upgrader for version %d", tt.version)
+			}
+			resp := resource.UpgradeStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{RawState: rawState}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			state := resp.State
+
+			testUTCheckStringAttributes(t, state, tt.expected)
+		})
+	}
+}
+
+// testUTUpgradeSchema is version 3 of a resource whose name was display_name before version 1 and
+// whose size was a string before version 3.
+var testUTUpgradeSchema = schema.Schema{
+	Version: 3,
+	Attributes: map[string]schema.Attribute{
+		"id":   schema.StringAttribute{Computed: true},
+		"name": schema.StringAttribute{Required: true},
+		"size": schema.Int64Attribute{Optional: true},
+	},
+}
+
+var testUTUpgrades = []rawStateUpgrade{
+	// 0 => 1: display_name is renamed to name.
+	func(state map[string]any) error {
+		state["name"] = state["display_name"]
+		delete(state, "display_name")
+		return nil
+	},
+	// 1 => 2: only new attributes.
+	nil,
+	// 2 => 3: size is a number.
+	func(state map[string]any) error {
+		size, ok := state["size"].(string)
+		if !ok {
+			return nil
+		}
+		n, err := strconv.ParseInt(size, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid size %q", size)
+		}
+		state["size"] = n
+		return nil
+	},
+}
+
+func TestUTStateUpgraders(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name     string
+		upgrades []rawStateUpgrade
+		version  int64
+		state    string
+		expected map[string]string
+		size     int64
+		wantErr  bool
+	}{
+		{
+			name:     "version 0",
+			upgrades: testUTUpgrades,
+			version:  0,
+			state:    `{"id": "1", "display_name": "test", "size": "10"}`,
+			expected: map[string]string{"id": "1", "name": "test"},
+			size:     10,
+		},
+		{
+			name:     "version 1",
+			upgrades: testUTUpgrades,
+			version:  1,
+			state:    `{"id": "1", "name": "test", "size": "20"}`,
+			expected: map[string]string{"id": "1", "name": "test"},
+			size:     20,
+		},
+		{
+			name:     "version 2 with removed attribute",
+			upgrades: testUTUpgrades,
+			version:  2,
+			state:    `{"id": "1", "name": "test", "size": "30", "removed": true}`,
+			expected: map[string]string{"id": "1", "name": "test"},
+			size:     30,
+		},
+		{
+			name:     "invalid value",
+			upgrades: testUTUpgrades,
+			version:  2,
+			state:    `{"id": "1", "name": "test", "size": "large"}`,
+			wantErr:  true,
+		},
+		{
+			name: "failed upgrade",
+			upgrades: []rawStateUpgrade{func(map[string]any) error {
+				return errors.New("unsupported state")
+			}, nil, nil},
+			version: 0,
+			state:   `{"id": "1", "name": "test"}`,
+			wantErr: true,
+		},
+		{
+			name:     "invalid json",
+			upgrades: testUTUpgrades,
+			version:  0,
+			state:    `{"id": `,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			upgraders := stateUpgraders(tt.upgrades)
+			if len(upgraders) != int(testUTUpgradeSchema.Version) {
+				t.Fatalf("expected %d upgraders, got %d", testUTUpgradeSchema.Version, len(upgraders))
+			}
+			resp := resource.UpgradeStateResponse{
+				State: tfsdk.State{Schema: testUTUpgradeSchema},
+			}
+			upgraders[tt.version].StateUpgrader(ctx, resource.UpgradeStateRequest{
+				RawState: &tfprotov6.RawState{JSON: []byte(tt.state)},
+			}, &resp)
+			if tt.wantErr {
+				if !resp.Diagnostics.HasError() {
+					t.Fatalf("expected error, got none")
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			testUTCheckStringAttributes(t, resp.State, tt.expected)
+			var size types.Int64
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("size"), &size)...)
+			if resp.Diagnostics.HasError() || size.ValueInt64() != tt.size {
+				t.Errorf("expected size %d, got %v: %v", tt.size, size, resp.Diagnostics)
+			}
+		})
+	}
+
+	if _, err := upgradeRawState(nil, testUTUpgradeSchema.Type().TerraformType(ctx), testUTUpgrades); err == nil {
+		t.Errorf("expected error for a state without json")
+	}
+}
+
+func testUTCheckStringAttributes(t *testing.T, state tfsdk.State, expected map[string]string) {
+	t.Helper()
+	for attribute, value := range expected {
+		p := path.Empty()
+		for _, name := range strings.Split(attribute, ".") {
+			p = p.AtName(name)
+		}
+		var actual types.String
+		diags := state.GetAttribute(context.Background(), p, &actual)
+		if diags.HasError() {
+			t.Fatalf("unable to get %s: %v", attribute, diags)
+		}
+		if actual.ValueString() != value {
+			t.Errorf("expected %s to be %q, got %q", attribute, value, actual.ValueString())
+		}
+	}
+}
+
+const testUTServerlessClusterStateV0 = `
+{
+  "annotations": {
+    "tidb.cloud/has-set-password": "false",
+    "tidb.cloud/available-features": "DELEGATE_USER,DISABLE_PUBLIC_LB,PRIVATE_LINK"
+  },
+  "automated_backup_policy": {
+    "retention_days": 14,
+    "start_time": "07:00"
+  },
+  "auto_scaling": null,
+  "cluster_id": "10000000000000000000",
+  "create_time": "2024-12-01T08:00:00Z",
+  "created_by": "apikey-XXXXXXXX",
+  "display_name": "test-tf",
+  "encryption_config": {
+    "enhanced_encryption_enabled": false
+  },
+  "endpoints": {
+    "private": {
+      "aws": {
+        "availability_zone": ["use1-az1"],
+        "service_name": "com.amazonaws.vpce.us-east-1.vpce-svc-00000000000000000"
+      },
+      "host": "gateway01-privatelink.us-east-1.prod.aws.tidbcloud.com",
+      "port": 4000
+    },
+    "public": {
+      "disabled": false,
+      "host": "gateway01.us-east-1.prod.aws.tidbcloud.com",
+      "port": 4000
+    }
+  },
+  "labels": {
+    "tidb.cloud/organization": "00000",
+    "tidb.cloud/project": "00000000"
+  },
+  "project_id": "00000000",
+  "region": {
+    "cloud_provider": "aws",
+    "display_name": "N. Virginia (us-east-1)",
+    "name": "regions/aws-us-east-1",
+    "region_id": "us-east-1"
+  },
+  "spending_limit": {
+    "monthly": 10
+  },
+  "state": "ACTIVE",
+  "update_time": "2024-12-01T08:01:00Z",
+  "user_prefix": "xxxxxxxxxxxxxxx",
+  "version": "v7.5.2"
+}
+`
+
+const testUTDedicatedClusterStateV0 = `
+{
+  "annotations": {
+    "tidb.cloud/available-features": ""
+  },
+  "cloud_provider": "aws",
+  "cluster_id": "10000000000000000001",
+  "create_time": "2024-12-01 08:00:00 +0000 UTC",
+  "created_by": "apikey-XXXXXXXX",
+  "display_name": "test-tf",
+  "labels": {
+    "tidb.cloud/organization": "00000",
+    "tidb.cloud/project": "00000000"
+  },
+  "pause_plan": {
+    "pause_type": "SCHEDULED",
+    "scheduled_resume_time": "2024-12-03 00:00:00 +0000 UTC"
+  },
+  "paused": true,
+  "port": 4000,
+  "project_id": "00000000",
+  "region_display_name": "N. Virginia (us-east-1)",
+  "region_id": "aws-us-east-1",
+  "root_password": "password",
+  "state": "PAUSED",
+  "tidb_node_setting": {
+    "endpoints": [
+      {
+        "connection_type": "PUBLIC",
+        "host": "tidb.xxxxxxxx.clusters.tidb-cloud.com",
+        "port": 4000
+      }
+    ],
+    "is_default_group": true,
+    "node_count": 2,
+    "node_group_display_name": "DefaultGroup",
+    "node_group_id": "1000000000",
+    "node_spec_display_name": "8 vCPU, 16 GiB",
+    "node_spec_key": "8C16G",
+    "public_endpoint_setting": {
+      "enabled": true,
+      "ip_access_list": [
+        {
+          "cidr_notation": "0.0.0.0/0",
+          "description": "all"
+        }
+      ]
+    },
+    "state": "ACTIVE",
+    "tiproxy_setting": null
+  },
+  "tiflash_node_setting": {
+    "node_count": 1,
+    "node_spec_display_name": "8 vCPU, 64 GiB",
+    "node_spec_key": "8C64G",
+    "raft_store_iops": null,
+    "storage_size_gi": 500,
+    "storage_type": "Standard"
+  },
+  "tikv_node_setting": {
+    "node_count": 3,
+    "node_spec_display_name": "8 vCPU, 32 GiB",
+    "node_spec_key": "8C32G",
+    "raft_store_iops": null,
+    "storage_size_gi": 500,
+    "storage_type": "Standard"
+  },
+  "update_time": "2024-12-02 09:30:15 +0000 UTC",
+  "version": "v8.1.2"
+}
+`
+
+const testUTServerlessExportStateV0 = `
+{
+  "cluster_id": "10000000000000000000",
+  "complete_time": "2024-12-01T08:05:00Z",
+  "create_time": "2024-12-01T08:00:00Z",
+  "created_by": "apikey-XXXXXXXX",
+  "display_name": "SNAPSHOT_2024-12-01T08:00:00Z",
+  "expire_time": "2024-12-03T08:05:00Z",
+  "export_id": "exp-00000000000000000000000000",
+  "export_options": {
+    "compression": "GZIP",
+    "csv_format": {
+      "delimiter": "\"",
+      "null_value": "\\N",
+      "separator": ",",
+      "skip_header": false
+    },
+    "file_type": "CSV",
+    "filter": null,
+    "parquet_format": null
+  },
+  "reason": null,
+  "snapshot_time": "2024-12-01T08:00:00Z",
+  "state": "SUCCEEDED",
+  "target": {
+    "azure_blob": null,
+    "gcs": null,
+    "s3": {
+      "access_key": {
+        "id": "id",
+        "secret": "secret"
+      },
+      "auth_type": "ACCESS_KEY",
+      "role_arn": null,
+      "uri": "s3://bucket/path"
+    },
+    "type": "S3"
+  },
+  "update_time": "2024-12-01T08:05:00Z"
+}
+`
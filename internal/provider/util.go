@@ -4,6 +4,7 @@ import (
 	cryptorand "crypto/rand"
 	"math/big"
 	"os"
+	"strings"
 )
 
 const (
@@ -13,6 +14,7 @@ const (
 	TiDBCloudDedicatedEndpoint  string = "TIDBCLOUD_DEDICATED_ENDPOINT"
 	TiDBCloudServerlessEndpoint string = "TIDBCLOUD_SERVERLESS_ENDPOINT"
 	TiDBCloudIAMEndpoint        string = "TIDBCLOUD_IAM_ENDPOINT"
+	TiDBCloudHTTPLog            string = "TIDBCLOUD_HTTP_LOG"
 	TiDBCloudProjectID          string = "TIDBCLOUD_PROJECT_ID"
 	TiDBCloudClusterID          string = "TIDBCLOUD_CLUSTER_ID"
 	UserAgent                   string = "terraform-provider-tidbcloud"
@@ -42,6 +44,28 @@ func GenerateRandomString(n int) string {
 	return string(b)
 }
 
+// GenerateRandomPassword returns a random password which contains at least one lower case letter,
+// one upper case letter and one digit.
+func GenerateRandomPassword(n int) string {
+	classes := []string{"abcdefghijklmnopqrstuvwxyz", "ABCDEFGHIJKLMNOPQRSTUVWXYZ", "0123456789"}
+	all := strings.Join(classes, "")
+	b := make([]byte, n)
+	for i := range b {
+		letters := all
+		if i < len(classes) {
+			letters = classes[i]
+		}
+		randNum, _ := cryptorand.Int(cryptorand.Reader, big.NewInt(int64(len(letters))))
+		b[i] = letters[randNum.Int64()]
+	}
+	// shuffle so the guaranteed characters are not always at the beginning
+	for i := len(b) - 1; i > 0; i-- {
+		j, _ := cryptorand.Int(cryptorand.Reader, big.NewInt(int64(i+1)))
+		b[i], b[j.Int64()] = b[j.Int64()], b[i]
+	}
+	return string(b)
+}
+
 type Knowable interface {
 	IsUnknown() bool
 	IsNull() bool
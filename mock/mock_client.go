@@ -5,6 +5,7 @@
 package mock
 
 import (
+	context "context"
 	os "os"
 	reflect "reflect"
 
@@ -40,9 +41,9 @@ func (m *MockTiDBCloudClient) EXPECT() *MockTiDBCloudClientMockRecorder {
 }
 
 // CancelImport mocks base method.
-func (m *MockTiDBCloudClient) CancelImport(params *import_service.CancelImportParams, opts ...import_service.ClientOption) (*import_service.CancelImportOK, error) {
+func (m *MockTiDBCloudClient) CancelImport(ctx context.Context, params *import_service.CancelImportParams, opts ...import_service.ClientOption) (*import_service.CancelImportOK, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{params}
+	varargs := []interface{}{ctx, params}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
@@ -53,16 +54,16 @@ func (m *MockTiDBCloudClient) CancelImport(params *import_service.CancelImportPa
 }
 
 // CancelImport indicates an expected call of CancelImport.
-func (mr *MockTiDBCloudClientMockRecorder) CancelImport(params interface{}, opts ...interface{}) *gomock.Call {
+func (mr *MockTiDBCloudClientMockRecorder) CancelImport(ctx, params interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{params}, opts...)
+	varargs := append([]interface{}{ctx, params}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelImport", reflect.TypeOf((*MockTiDBCloudClient)(nil).CancelImport), varargs...)
 }
 
 // CreateBackup mocks base method.
-func (m *MockTiDBCloudClient) CreateBackup(params *backup.CreateBackupParams, opts ...backup.ClientOption) (*backup.CreateBackupOK, error) {
+func (m *MockTiDBCloudClient) CreateBackup(ctx context.Context, params *backup.CreateBackupParams, opts ...backup.ClientOption) (*backup.CreateBackupOK, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{params}
+	varargs := []interface{}{ctx, params}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
@@ -73,16 +74,16 @@ func (m *MockTiDBCloudClient) CreateBackup(params *backup.CreateBackupParams, op
 }
 
 // CreateBackup indicates an expected call of CreateBackup.
-func (mr *MockTiDBCloudClientMockRecorder) CreateBackup(params interface{}, opts ...interface{}) *gomock.Call {
+func (mr *MockTiDBCloudClientMockRecorder) CreateBackup(ctx, params interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{params}, opts...)
+	varargs := append([]interface{}{ctx, params}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBackup", reflect.TypeOf((*MockTiDBCloudClient)(nil).CreateBackup), varargs...)
 }
 
 // CreateCluster mocks base method.
-func (m *MockTiDBCloudClient) CreateCluster(params *cluster.CreateClusterParams, opts ...cluster.ClientOption) (*cluster.CreateClusterOK, error) {
+func (m *MockTiDBCloudClient) CreateCluster(ctx context.Context, params *cluster.CreateClusterParams, opts ...cluster.ClientOption) (*cluster.CreateClusterOK, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{params}
+	varargs := []interface{}{ctx, params}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
@@ -93,16 +94,16 @@ func (m *MockTiDBCloudClient) CreateCluster(params *cluster.CreateClusterParams,
 }
 
 // CreateCluster indicates an expected call of CreateCluster.
-func (mr *MockTiDBCloudClientMockRecorder) CreateCluster(params interface{}, opts ...interface{}) *gomock.Call {
+func (mr *MockTiDBCloudClientMockRecorder) CreateCluster(ctx, params interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{params}, opts...)
+	varargs := append([]interface{}{ctx, params}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCluster", reflect.TypeOf((*MockTiDBCloudClient)(nil).CreateCluster), varargs...)
 }
 
 // CreateImport mocks base method.
-func (m *MockTiDBCloudClient) CreateImport(params *import_service.CreateImportParams, opts ...import_service.ClientOption) (*import_service.CreateImportOK, error) {
+func (m *MockTiDBCloudClient) CreateImport(ctx context.Context, params *import_service.CreateImportParams, opts ...import_service.ClientOption) (*import_service.CreateImportOK, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{params}
+	varargs := []interface{}{ctx, params}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
@@ -113,16 +114,16 @@ func (m *MockTiDBCloudClient) CreateImport(params *import_service.CreateImportPa
 }
 
 // CreateImport indicates an expected call of CreateImport.
-func (mr *MockTiDBCloudClientMockRecorder) CreateImport(params interface{}, opts ...interface{}) *gomock.Call {
+func (mr *MockTiDBCloudClientMockRecorder) CreateImport(ctx, params interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{params}, opts...)
+	varargs := append([]interface{}{ctx, params}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImport", reflect.TypeOf((*MockTiDBCloudClient)(nil).CreateImport), varargs...)
 }
 
 // CreateRestoreTask mocks base method.
-func (m *MockTiDBCloudClient) CreateRestoreTask(params *restore.CreateRestoreTaskParams, opts ...restore.ClientOption) (*restore.CreateRestoreTaskOK, error) {
+func (m *MockTiDBCloudClient) CreateRestoreTask(ctx context.Context, params *restore.CreateRestoreTaskParams, opts ...restore.ClientOption) (*restore.CreateRestoreTaskOK, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{params}
+	varargs := []interface{}{ctx, params}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
@@ -133,16 +134,16 @@ func (m *MockTiDBCloudClient) CreateRestoreTask(params *restore.CreateRestoreTas
 }
 
 // CreateRestoreTask indicates an expected call of CreateRestoreTask.
-func (mr *MockTiDBCloudClientMockRecorder) CreateRestoreTask(params interface{}, opts ...interface{}) *gomock.Call {
+func (mr *MockTiDBCloudClientMockRecorder) CreateRestoreTask(ctx, params interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{params}, opts...)
+	varargs := append([]interface{}{ctx, params}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRestoreTask", reflect.TypeOf((*MockTiDBCloudClient)(nil).CreateRestoreTask), varargs...)
 }
 
 // DeleteBackup mocks base method.
-func (m *MockTiDBCloudClient) DeleteBackup(params *backup.DeleteBackupParams, opts ...backup.ClientOption) (*backup.DeleteBackupOK, error) {
+func (m *MockTiDBCloudClient) DeleteBackup(ctx context.Context, params *backup.DeleteBackupParams, opts ...backup.ClientOption) (*backup.DeleteBackupOK, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{params}
+	varargs := []interface{}{ctx, params}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
@@ -153,16 +154,16 @@ func (m *MockTiDBCloudClient) DeleteBackup(params *backup.DeleteBackupParams, op
 }
 
 // DeleteBackup indicates an expected call of DeleteBackup.
-func (mr *MockTiDBCloudClientMockRecorder) DeleteBackup(params interface{}, opts ...interface{}) *gomock.Call {
+func (mr *MockTiDBCloudClientMockRecorder) DeleteBackup(ctx, params interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{params}, opts...)
+	varargs := append([]interface{}{ctx, params}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBackup", reflect.TypeOf((*MockTiDBCloudClient)(nil).DeleteBackup), varargs...)
 }
 
 // DeleteCluster mocks base method.
-func (m *MockTiDBCloudClient) DeleteCluster(params *cluster.DeleteClusterParams, opts ...cluster.ClientOption) (*cluster.DeleteClusterOK, error) {
+func (m *MockTiDBCloudClient) DeleteCluster(ctx context.Context, params *cluster.DeleteClusterParams, opts ...cluster.ClientOption) (*cluster.DeleteClusterOK, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{params}
+	varargs := []interface{}{ctx, params}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
@@ -173,16 +174,16 @@ func (m *MockTiDBCloudClient) DeleteCluster(params *cluster.DeleteClusterParams,
 }
 
 // DeleteCluster indicates an expected call of DeleteCluster.
-func (mr *MockTiDBCloudClientMockRecorder) DeleteCluster(params interface{}, opts ...interface{}) *gomock.Call {
+func (mr *MockTiDBCloudClientMockRecorder) DeleteCluster(ctx, params interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{params}, opts...)
+	varargs := append([]interface{}{ctx, params}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCluster", reflect.TypeOf((*MockTiDBCloudClient)(nil).DeleteCluster), varargs...)
 }
 
 // GenerateUploadURL mocks base method.
-func (m *MockTiDBCloudClient) GenerateUploadURL(params *import_service.GenerateUploadURLParams, opts ...import_service.ClientOption) (*import_service.GenerateUploadURLOK, error) {
+func (m *MockTiDBCloudClient) GenerateUploadURL(ctx context.Context, params *import_service.GenerateUploadURLParams, opts ...import_service.ClientOption) (*import_service.GenerateUploadURLOK, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{params}
+	varargs := []interface{}{ctx, params}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
@@ -193,16 +194,16 @@ func (m *MockTiDBCloudClient) GenerateUploadURL(params *import_service.GenerateU
 }
 
 // GenerateUploadURL indicates an expected call of GenerateUploadURL.
-func (mr *MockTiDBCloudClientMockRecorder) GenerateUploadURL(params interface{}, opts ...interface{}) *gomock.Call {
+func (mr *MockTiDBCloudClientMockRecorder) GenerateUploadURL(ctx, params interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{params}, opts...)
+	varargs := append([]interface{}{ctx, params}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateUploadURL", reflect.TypeOf((*MockTiDBCloudClient)(nil).GenerateUploadURL), varargs...)
 }
 
 // GetBackupOfCluster mocks base method.
-func (m *MockTiDBCloudClient) GetBackupOfCluster(params *backup.GetBackupOfClusterParams, opts ...backup.ClientOption) (*backup.GetBackupOfClusterOK, error) {
+func (m *MockTiDBCloudClient) GetBackupOfCluster(ctx context.Context, params *backup.GetBackupOfClusterParams, opts ...backup.ClientOption) (*backup.GetBackupOfClusterOK, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{params}
+	varargs := []interface{}{ctx, params}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
@@ -213,16 +214,16 @@ func (m *MockTiDBCloudClient) GetBackupOfCluster(params *backup.GetBackupOfClust
 }
 
 // GetBackupOfCluster indicates an expected call of GetBackupOfCluster.
-func (mr *MockTiDBCloudClientMockRecorder) GetBackupOfCluster(params interface{}, opts ...interface{}) *gomock.Call {
+func (mr *MockTiDBCloudClientMockRecorder) GetBackupOfCluster(ctx, params interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{params}, opts...)
+	varargs := append([]interface{}{ctx, params}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupOfCluster", reflect.TypeOf((*MockTiDBCloudClient)(nil).GetBackupOfCluster), varargs...)
 }
 
 // GetCluster mocks base method.
-func (m *MockTiDBCloudClient) GetCluster(params *cluster.GetClusterParams, opts ...cluster.ClientOption) (*cluster.GetClusterOK, error) {
+func (m *MockTiDBCloudClient) GetCluster(ctx context.Context, params *cluster.GetClusterParams, opts ...cluster.ClientOption) (*cluster.GetClusterOK, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{params}
+	varargs := []interface{}{ctx, params}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
@@ -233,16 +234,16 @@ func (m *MockTiDBCloudClient) GetCluster(params *cluster.GetClusterParams, opts
 }
 
 // GetCluster indicates an expected call of GetCluster.
-func (mr *MockTiDBCloudClientMockRecorder) GetCluster(params interface{}, opts ...interface{}) *gomock.Call {
+func (mr *MockTiDBCloudClientMockRecorder) GetCluster(ctx, params interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{params}, opts...)
+	varargs := append([]interface{}{ctx, params}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCluster", reflect.TypeOf((*MockTiDBCloudClient)(nil).GetCluster), varargs...)
 }
 
 // GetImport mocks base method.
-func (m *MockTiDBCloudClient) GetImport(params *import_service.GetImportParams, opts ...import_service.ClientOption) (*import_service.GetImportOK, error) {
+func (m *MockTiDBCloudClient) GetImport(ctx context.Context, params *import_service.GetImportParams, opts ...import_service.ClientOption) (*import_service.GetImportOK, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{params}
+	varargs := []interface{}{ctx, params}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
@@ -253,16 +254,16 @@ func (m *MockTiDBCloudClient) GetImport(params *import_service.GetImportParams,
 }
 
 // GetImport indicates an expected call of GetImport.
-func (mr *MockTiDBCloudClientMockRecorder) GetImport(params interface{}, opts ...interface{}) *gomock.Call {
+func (mr *MockTiDBCloudClientMockRecorder) GetImport(ctx, params interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{params}, opts...)
+	varargs := append([]interface{}{ctx, params}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImport", reflect.TypeOf((*MockTiDBCloudClient)(nil).GetImport), varargs...)
 }
 
 // GetRestoreTask mocks base method.
-func (m *MockTiDBCloudClient) GetRestoreTask(params *restore.GetRestoreTaskParams, opts ...restore.ClientOption) (*restore.GetRestoreTaskOK, error) {
+func (m *MockTiDBCloudClient) GetRestoreTask(ctx context.Context, params *restore.GetRestoreTaskParams, opts ...restore.ClientOption) (*restore.GetRestoreTaskOK, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{params}
+	varargs := []interface{}{ctx, params}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
@@ -273,16 +274,16 @@ func (m *MockTiDBCloudClient) GetRestoreTask(params *restore.GetRestoreTaskParam
 }
 
 // GetRestoreTask indicates an expected call of GetRestoreTask.
-func (mr *MockTiDBCloudClientMockRecorder) GetRestoreTask(params interface{}, opts ...interface{}) *gomock.Call {
+func (mr *MockTiDBCloudClientMockRecorder) GetRestoreTask(ctx, params interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{params}, opts...)
+	varargs := append([]interface{}{ctx, params}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRestoreTask", reflect.TypeOf((*MockTiDBCloudClient)(nil).GetRestoreTask), varargs...)
 }
 
 // ListBackUpOfCluster mocks base method.
-func (m *MockTiDBCloudClient) ListBackUpOfCluster(params *backup.ListBackUpOfClusterParams, opts ...backup.ClientOption) (*backup.ListBackUpOfClusterOK, error) {
+func (m *MockTiDBCloudClient) ListBackUpOfCluster(ctx context.Context, params *backup.ListBackUpOfClusterParams, opts ...backup.ClientOption) (*backup.ListBackUpOfClusterOK, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{params}
+	varargs := []interface{}{ctx, params}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
@@ -293,16 +294,16 @@ func (m *MockTiDBCloudClient) ListBackUpOfCluster(params *backup.ListBackUpOfClu
 }
 
 // ListBackUpOfCluster indicates an expected call of ListBackUpOfCluster.
-func (mr *MockTiDBCloudClientMockRecorder) ListBackUpOfCluster(params interface{}, opts ...interface{}) *gomock.Call {
+func (mr *MockTiDBCloudClientMockRecorder) ListBackUpOfCluster(ctx, params interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{params}, opts...)
+	varargs := append([]interface{}{ctx, params}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackUpOfCluster", reflect.TypeOf((*MockTiDBCloudClient)(nil).ListBackUpOfCluster), varargs...)
 }
 
 // ListClustersOfProject mocks base method.
-func (m *MockTiDBCloudClient) ListClustersOfProject(params *cluster.ListClustersOfProjectParams, opts ...cluster.ClientOption) (*cluster.ListClustersOfProjectOK, error) {
+func (m *MockTiDBCloudClient) ListClustersOfProject(ctx context.Context, params *cluster.ListClustersOfProjectParams, opts ...cluster.ClientOption) (*cluster.ListClustersOfProjectOK, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{params}
+	varargs := []interface{}{ctx, params}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
@@ -313,16 +314,16 @@ func (m *MockTiDBCloudClient) ListClustersOfProject(params *cluster.ListClusters
 }
 
 // ListClustersOfProject indicates an expected call of ListClustersOfProject.
-func (mr *MockTiDBCloudClientMockRecorder) ListClustersOfProject(params interface{}, opts ...interface{}) *gomock.Call {
+func (mr *MockTiDBCloudClientMockRecorder) ListClustersOfProject(ctx, params interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{params}, opts...)
+	varargs := append([]interface{}{ctx, params}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClustersOfProject", reflect.TypeOf((*MockTiDBCloudClient)(nil).ListClustersOfProject), varargs...)
 }
 
 // ListImports mocks base method.
-func (m *MockTiDBCloudClient) ListImports(params *import_service.ListImportsParams, opts ...import_service.ClientOption) (*import_service.ListImportsOK, error) {
+func (m *MockTiDBCloudClient) ListImports(ctx context.Context, params *import_service.ListImportsParams, opts ...import_service.ClientOption) (*import_service.ListImportsOK, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{params}
+	varargs := []interface{}{ctx, params}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
@@ -333,16 +334,16 @@ func (m *MockTiDBCloudClient) ListImports(params *import_service.ListImportsPara
 }
 
 // ListImports indicates an expected call of ListImports.
-func (mr *MockTiDBCloudClientMockRecorder) ListImports(params interface{}, opts ...interface{}) *gomock.Call {
+func (mr *MockTiDBCloudClientMockRecorder) ListImports(ctx, params interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{params}, opts...)
+	varargs := append([]interface{}{ctx, params}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImports", reflect.TypeOf((*MockTiDBCloudClient)(nil).ListImports), varargs...)
 }
 
 // ListProjects mocks base method.
-func (m *MockTiDBCloudClient) ListProjects(params *project.ListProjectsParams, opts ...project.ClientOption) (*project.ListProjectsOK, error) {
+func (m *MockTiDBCloudClient) ListProjects(ctx context.Context, params *project.ListProjectsParams, opts ...project.ClientOption) (*project.ListProjectsOK, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{params}
+	varargs := []interface{}{ctx, params}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
@@ -353,16 +354,16 @@ func (m *MockTiDBCloudClient) ListProjects(params *project.ListProjectsParams, o
 }
 
 // ListProjects indicates an expected call of ListProjects.
-func (mr *MockTiDBCloudClientMockRecorder) ListProjects(params interface{}, opts ...interface{}) *gomock.Call {
+func (mr *MockTiDBCloudClientMockRecorder) ListProjects(ctx, params interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{params}, opts...)
+	varargs := append([]interface{}{ctx, params}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockTiDBCloudClient)(nil).ListProjects), varargs...)
 }
 
 // ListProviderRegions mocks base method.
-func (m *MockTiDBCloudClient) ListProviderRegions(params *cluster.ListProviderRegionsParams, opts ...cluster.ClientOption) (*cluster.ListProviderRegionsOK, error) {
+func (m *MockTiDBCloudClient) ListProviderRegions(ctx context.Context, params *cluster.ListProviderRegionsParams, opts ...cluster.ClientOption) (*cluster.ListProviderRegionsOK, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{params}
+	varargs := []interface{}{ctx, params}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
@@ -373,16 +374,16 @@ func (m *MockTiDBCloudClient) ListProviderRegions(params *cluster.ListProviderRe
 }
 
 // ListProviderRegions indicates an expected call of ListProviderRegions.
-func (mr *MockTiDBCloudClientMockRecorder) ListProviderRegions(params interface{}, opts ...interface{}) *gomock.Call {
+func (mr *MockTiDBCloudClientMockRecorder) ListProviderRegions(ctx, params interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{params}, opts...)
+	varargs := append([]interface{}{ctx, params}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProviderRegions", reflect.TypeOf((*MockTiDBCloudClient)(nil).ListProviderRegions), varargs...)
 }
 
 // ListRestoreTasks mocks base method.
-func (m *MockTiDBCloudClient) ListRestoreTasks(params *restore.ListRestoreTasksParams, opts ...restore.ClientOption) (*restore.ListRestoreTasksOK, error) {
+func (m *MockTiDBCloudClient) ListRestoreTasks(ctx context.Context, params *restore.ListRestoreTasksParams, opts ...restore.ClientOption) (*restore.ListRestoreTasksOK, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{params}
+	varargs := []interface{}{ctx, params}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
@@ -393,30 +394,30 @@ func (m *MockTiDBCloudClient) ListRestoreTasks(params *restore.ListRestoreTasksP
 }
 
 // ListRestoreTasks indicates an expected call of ListRestoreTasks.
-func (mr *MockTiDBCloudClientMockRecorder) ListRestoreTasks(params interface{}, opts ...interface{}) *gomock.Call {
+func (mr *MockTiDBCloudClientMockRecorder) ListRestoreTasks(ctx, params interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{params}, opts...)
+	varargs := append([]interface{}{ctx, params}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRestoreTasks", reflect.TypeOf((*MockTiDBCloudClient)(nil).ListRestoreTasks), varargs...)
 }
 
 // PreSignedUrlUpload mocks base method.
-func (m *MockTiDBCloudClient) PreSignedUrlUpload(url *string, uploadFile *os.File, size int64) error {
+func (m *MockTiDBCloudClient) PreSignedUrlUpload(ctx context.Context, url *string, uploadFile *os.File, size int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PreSignedUrlUpload", url, uploadFile, size)
+	ret := m.ctrl.Call(m, "PreSignedUrlUpload", ctx, url, uploadFile, size)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // PreSignedUrlUpload indicates an expected call of PreSignedUrlUpload.
-func (mr *MockTiDBCloudClientMockRecorder) PreSignedUrlUpload(url, uploadFile, size interface{}) *gomock.Call {
+func (mr *MockTiDBCloudClientMockRecorder) PreSignedUrlUpload(ctx, url, uploadFile, size interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreSignedUrlUpload", reflect.TypeOf((*MockTiDBCloudClient)(nil).PreSignedUrlUpload), url, uploadFile, size)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreSignedUrlUpload", reflect.TypeOf((*MockTiDBCloudClient)(nil).PreSignedUrlUpload), ctx, url, uploadFile, size)
 }
 
 // UpdateCluster mocks base method.
-func (m *MockTiDBCloudClient) UpdateCluster(params *cluster.UpdateClusterParams, opts ...cluster.ClientOption) (*cluster.UpdateClusterOK, error) {
+func (m *MockTiDBCloudClient) UpdateCluster(ctx context.Context, params *cluster.UpdateClusterParams, opts ...cluster.ClientOption) (*cluster.UpdateClusterOK, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{params}
+	varargs := []interface{}{ctx, params}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
@@ -427,8 +428,8 @@ func (m *MockTiDBCloudClient) UpdateCluster(params *cluster.UpdateClusterParams,
 }
 
 // UpdateCluster indicates an expected call of UpdateCluster.
-func (mr *MockTiDBCloudClientMockRecorder) UpdateCluster(params interface{}, opts ...interface{}) *gomock.Call {
+func (mr *MockTiDBCloudClientMockRecorder) UpdateCluster(ctx, params interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{params}, opts...)
+	varargs := append([]interface{}{ctx, params}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCluster", reflect.TypeOf((*MockTiDBCloudClient)(nil).UpdateCluster), varargs...)
 }
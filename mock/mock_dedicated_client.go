@@ -360,6 +360,21 @@ func (mr *MockTiDBCloudDedicatedClientMockRecorder) ListNetworkContainers(ctx, p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkContainers", reflect.TypeOf((*MockTiDBCloudDedicatedClient)(nil).ListNetworkContainers), ctx, projectId, pageSize, pageToken)
 }
 
+// ListNodeSpecs mocks base method.
+func (m *MockTiDBCloudDedicatedClient) ListNodeSpecs(ctx context.Context, regionId string) ([]dedicated.Dedicatedv1beta1NodeSpec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNodeSpecs", ctx, regionId)
+	ret0, _ := ret[0].([]dedicated.Dedicatedv1beta1NodeSpec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNodeSpecs indicates an expected call of ListNodeSpecs.
+func (mr *MockTiDBCloudDedicatedClientMockRecorder) ListNodeSpecs(ctx, regionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNodeSpecs", reflect.TypeOf((*MockTiDBCloudDedicatedClient)(nil).ListNodeSpecs), ctx, regionId)
+}
+
 // ListPrivateEndpointConnections mocks base method.
 func (m *MockTiDBCloudDedicatedClient) ListPrivateEndpointConnections(ctx context.Context, clusterId, nodeGroupId string, pageSize *int32, pageToken *string) (*dedicated.Dedicatedv1beta1ListPrivateEndpointConnectionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1,6 +1,7 @@
 package tidbcloud
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -15,7 +16,6 @@ import (
 	"github.com/c4pt0r/go-tidbcloud-sdk-v1/client/project"
 	httpTransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
-	"github.com/icholy/digest"
 	importClient "github.com/tidbcloud/terraform-provider-tidbcloud/pkg/import/client"
 )
 
@@ -25,147 +25,174 @@ const (
 )
 
 type TiDBCloudClient interface {
-	CreateCluster(params *cluster.CreateClusterParams, opts ...cluster.ClientOption) (*cluster.CreateClusterOK, error)
+	CreateCluster(ctx context.Context, params *cluster.CreateClusterParams, opts ...cluster.ClientOption) (*cluster.CreateClusterOK, error)
 
-	UpdateCluster(params *cluster.UpdateClusterParams, opts ...cluster.ClientOption) (*cluster.UpdateClusterOK, error)
+	UpdateCluster(ctx context.Context, params *cluster.UpdateClusterParams, opts ...cluster.ClientOption) (*cluster.UpdateClusterOK, error)
 
-	DeleteCluster(params *cluster.DeleteClusterParams, opts ...cluster.ClientOption) (*cluster.DeleteClusterOK, error)
+	DeleteCluster(ctx context.Context, params *cluster.DeleteClusterParams, opts ...cluster.ClientOption) (*cluster.DeleteClusterOK, error)
 
-	GetCluster(params *cluster.GetClusterParams, opts ...cluster.ClientOption) (*cluster.GetClusterOK, error)
+	GetCluster(ctx context.Context, params *cluster.GetClusterParams, opts ...cluster.ClientOption) (*cluster.GetClusterOK, error)
 
-	ListClustersOfProject(params *cluster.ListClustersOfProjectParams, opts ...cluster.ClientOption) (*cluster.ListClustersOfProjectOK, error)
+	ListClustersOfProject(ctx context.Context, params *cluster.ListClustersOfProjectParams, opts ...cluster.ClientOption) (*cluster.ListClustersOfProjectOK, error)
 
-	ListProviderRegions(params *cluster.ListProviderRegionsParams, opts ...cluster.ClientOption) (*cluster.ListProviderRegionsOK, error)
+	ListProviderRegions(ctx context.Context, params *cluster.ListProviderRegionsParams, opts ...cluster.ClientOption) (*cluster.ListProviderRegionsOK, error)
 
-	ListProjects(params *project.ListProjectsParams, opts ...project.ClientOption) (*project.ListProjectsOK, error)
+	ListProjects(ctx context.Context, params *project.ListProjectsParams, opts ...project.ClientOption) (*project.ListProjectsOK, error)
 
-	CreateBackup(params *backup.CreateBackupParams, opts ...backup.ClientOption) (*backup.CreateBackupOK, error)
+	CreateBackup(ctx context.Context, params *backup.CreateBackupParams, opts ...backup.ClientOption) (*backup.CreateBackupOK, error)
 
-	DeleteBackup(params *backup.DeleteBackupParams, opts ...backup.ClientOption) (*backup.DeleteBackupOK, error)
+	DeleteBackup(ctx context.Context, params *backup.DeleteBackupParams, opts ...backup.ClientOption) (*backup.DeleteBackupOK, error)
 
-	GetBackupOfCluster(params *backup.GetBackupOfClusterParams, opts ...backup.ClientOption) (*backup.GetBackupOfClusterOK, error)
+	GetBackupOfCluster(ctx context.Context, params *backup.GetBackupOfClusterParams, opts ...backup.ClientOption) (*backup.GetBackupOfClusterOK, error)
 
-	ListBackUpOfCluster(params *backup.ListBackUpOfClusterParams, opts ...backup.ClientOption) (*backup.ListBackUpOfClusterOK, error)
+	ListBackUpOfCluster(ctx context.Context, params *backup.ListBackUpOfClusterParams, opts ...backup.ClientOption) (*backup.ListBackUpOfClusterOK, error)
 
-	CreateRestoreTask(params *restore.CreateRestoreTaskParams, opts ...restore.ClientOption) (*restore.CreateRestoreTaskOK, error)
+	CreateRestoreTask(ctx context.Context, params *restore.CreateRestoreTaskParams, opts ...restore.ClientOption) (*restore.CreateRestoreTaskOK, error)
 
-	GetRestoreTask(params *restore.GetRestoreTaskParams, opts ...restore.ClientOption) (*restore.GetRestoreTaskOK, error)
+	GetRestoreTask(ctx context.Context, params *restore.GetRestoreTaskParams, opts ...restore.ClientOption) (*restore.GetRestoreTaskOK, error)
 
-	ListRestoreTasks(params *restore.ListRestoreTasksParams, opts ...restore.ClientOption) (*restore.ListRestoreTasksOK, error)
+	ListRestoreTasks(ctx context.Context, params *restore.ListRestoreTasksParams, opts ...restore.ClientOption) (*restore.ListRestoreTasksOK, error)
 
-	CancelImport(params *importService.CancelImportParams, opts ...importService.ClientOption) (*importService.CancelImportOK, error)
+	CancelImport(ctx context.Context, params *importService.CancelImportParams, opts ...importService.ClientOption) (*importService.CancelImportOK, error)
 
-	CreateImport(params *importService.CreateImportParams, opts ...importService.ClientOption) (*importService.CreateImportOK, error)
+	CreateImport(ctx context.Context, params *importService.CreateImportParams, opts ...importService.ClientOption) (*importService.CreateImportOK, error)
 
-	GetImport(params *importService.GetImportParams, opts ...importService.ClientOption) (*importService.GetImportOK, error)
+	GetImport(ctx context.Context, params *importService.GetImportParams, opts ...importService.ClientOption) (*importService.GetImportOK, error)
 
-	ListImports(params *importService.ListImportsParams, opts ...importService.ClientOption) (*importService.ListImportsOK, error)
+	ListImports(ctx context.Context, params *importService.ListImportsParams, opts ...importService.ClientOption) (*importService.ListImportsOK, error)
 
-	GenerateUploadURL(params *importService.GenerateUploadURLParams, opts ...importService.ClientOption) (*importService.GenerateUploadURLOK, error)
+	GenerateUploadURL(ctx context.Context, params *importService.GenerateUploadURLParams, opts ...importService.ClientOption) (*importService.GenerateUploadURLOK, error)
 
-	PreSignedUrlUpload(url *string, uploadFile *os.File, size int64) error
+	PreSignedUrlUpload(ctx context.Context, url *string, uploadFile *os.File, size int64) error
 }
 
 type ClientDelegate struct {
 	c  *apiClient.GoTidbcloud
 	ic *importClient.GoTidbcloudImport
+	// uploadClient is used to upload files to the presigned url, which must not carry the digest auth.
+	uploadClient *http.Client
 }
 
-func NewClientDelegate(publicKey string, privateKey string, apiUrl string, userAgent string) (TiDBCloudClient, error) {
-	c, ic, err := NewApiClient(publicKey, privateKey, apiUrl, userAgent)
+func NewClientDelegate(publicKey string, privateKey string, apiUrl string, userAgent string, baseTransport http.RoundTripper) (TiDBCloudClient, error) {
+	c, ic, err := NewApiClient(publicKey, privateKey, apiUrl, userAgent, baseTransport)
 	if err != nil {
 		return nil, err
 	}
+	if baseTransport == nil {
+		baseTransport = http.DefaultTransport
+	}
 	return &ClientDelegate{
 		c:  c,
 		ic: ic,
+		uploadClient: &http.Client{
+			Transport: NewTransportWithAgent(baseTransport, userAgent),
+		},
 	}, nil
 }
 
-func (d *ClientDelegate) CreateCluster(params *cluster.CreateClusterParams, opts ...cluster.ClientOption) (*cluster.CreateClusterOK, error) {
-	return d.c.Cluster.CreateCluster(params, opts...)
+func (d *ClientDelegate) CreateCluster(ctx context.Context, params *cluster.CreateClusterParams, opts ...cluster.ClientOption) (*cluster.CreateClusterOK, error) {
+	resp, err := d.c.Cluster.CreateCluster(params.WithContext(ctx), opts...)
+	return resp, parseLegacyError(err)
 }
 
-func (d *ClientDelegate) UpdateCluster(params *cluster.UpdateClusterParams, opts ...cluster.ClientOption) (*cluster.UpdateClusterOK, error) {
-	return d.c.Cluster.UpdateCluster(params, opts...)
+func (d *ClientDelegate) UpdateCluster(ctx context.Context, params *cluster.UpdateClusterParams, opts ...cluster.ClientOption) (*cluster.UpdateClusterOK, error) {
+	resp, err := d.c.Cluster.UpdateCluster(params.WithContext(ctx), opts...)
+	return resp, parseLegacyError(err)
 }
 
-func (d *ClientDelegate) DeleteCluster(params *cluster.DeleteClusterParams, opts ...cluster.ClientOption) (*cluster.DeleteClusterOK, error) {
-	return d.c.Cluster.DeleteCluster(params, opts...)
+func (d *ClientDelegate) DeleteCluster(ctx context.Context, params *cluster.DeleteClusterParams, opts ...cluster.ClientOption) (*cluster.DeleteClusterOK, error) {
+	resp, err := d.c.Cluster.DeleteCluster(params.WithContext(ctx), opts...)
+	return resp, parseLegacyError(err)
 }
 
-func (d *ClientDelegate) GetCluster(params *cluster.GetClusterParams, opts ...cluster.ClientOption) (*cluster.GetClusterOK, error) {
-	return d.c.Cluster.GetCluster(params, opts...)
+func (d *ClientDelegate) GetCluster(ctx context.Context, params *cluster.GetClusterParams, opts ...cluster.ClientOption) (*cluster.GetClusterOK, error) {
+	resp, err := d.c.Cluster.GetCluster(params.WithContext(ctx), opts...)
+	return resp, parseLegacyError(err)
 }
 
-func (d *ClientDelegate) ListProviderRegions(params *cluster.ListProviderRegionsParams, opts ...cluster.ClientOption) (*cluster.ListProviderRegionsOK, error) {
-	return d.c.Cluster.ListProviderRegions(params, opts...)
+func (d *ClientDelegate) ListProviderRegions(ctx context.Context, params *cluster.ListProviderRegionsParams, opts ...cluster.ClientOption) (*cluster.ListProviderRegionsOK, error) {
+	resp, err := d.c.Cluster.ListProviderRegions(params.WithContext(ctx), opts...)
+	return resp, parseLegacyError(err)
 }
 
-func (d *ClientDelegate) ListClustersOfProject(params *cluster.ListClustersOfProjectParams, opts ...cluster.ClientOption) (*cluster.ListClustersOfProjectOK, error) {
-	return d.c.Cluster.ListClustersOfProject(params, opts...)
+func (d *ClientDelegate) ListClustersOfProject(ctx context.Context, params *cluster.ListClustersOfProjectParams, opts ...cluster.ClientOption) (*cluster.ListClustersOfProjectOK, error) {
+	resp, err := d.c.Cluster.ListClustersOfProject(params.WithContext(ctx), opts...)
+	return resp, parseLegacyError(err)
 }
 
-func (d *ClientDelegate) ListProjects(params *project.ListProjectsParams, opts ...project.ClientOption) (*project.ListProjectsOK, error) {
-	return d.c.Project.ListProjects(params, opts...)
+func (d *ClientDelegate) ListProjects(ctx context.Context, params *project.ListProjectsParams, opts ...project.ClientOption) (*project.ListProjectsOK, error) {
+	resp, err := d.c.Project.ListProjects(params.WithContext(ctx), opts...)
+	return resp, parseLegacyError(err)
 }
 
-func (d *ClientDelegate) CreateBackup(params *backup.CreateBackupParams, opts ...backup.ClientOption) (*backup.CreateBackupOK, error) {
-	return d.c.Backup.CreateBackup(params, opts...)
+func (d *ClientDelegate) CreateBackup(ctx context.Context, params *backup.CreateBackupParams, opts ...backup.ClientOption) (*backup.CreateBackupOK, error) {
+	resp, err := d.c.Backup.CreateBackup(params.WithContext(ctx), opts...)
+	return resp, parseLegacyError(err)
 }
 
-func (d *ClientDelegate) DeleteBackup(params *backup.DeleteBackupParams, opts ...backup.ClientOption) (*backup.DeleteBackupOK, error) {
-	return d.c.Backup.DeleteBackup(params, opts...)
+func (d *ClientDelegate) DeleteBackup(ctx context.Context, params *backup.DeleteBackupParams, opts ...backup.ClientOption) (*backup.DeleteBackupOK, error) {
+	resp, err := d.c.Backup.DeleteBackup(params.WithContext(ctx), opts...)
+	return resp, parseLegacyError(err)
 }
 
-func (d *ClientDelegate) GetBackupOfCluster(params *backup.GetBackupOfClusterParams, opts ...backup.ClientOption) (*backup.GetBackupOfClusterOK, error) {
-	return d.c.Backup.GetBackupOfCluster(params, opts...)
+func (d *ClientDelegate) GetBackupOfCluster(ctx context.Context, params *backup.GetBackupOfClusterParams, opts ...backup.ClientOption) (*backup.GetBackupOfClusterOK, error) {
+	resp, err := d.c.Backup.GetBackupOfCluster(params.WithContext(ctx), opts...)
+	return resp, parseLegacyError(err)
 }
 
-func (d *ClientDelegate) ListBackUpOfCluster(params *backup.ListBackUpOfClusterParams, opts ...backup.ClientOption) (*backup.ListBackUpOfClusterOK, error) {
-	return d.c.Backup.ListBackUpOfCluster(params, opts...)
+func (d *ClientDelegate) ListBackUpOfCluster(ctx context.Context, params *backup.ListBackUpOfClusterParams, opts ...backup.ClientOption) (*backup.ListBackUpOfClusterOK, error) {
+	resp, err := d.c.Backup.ListBackUpOfCluster(params.WithContext(ctx), opts...)
+	return resp, parseLegacyError(err)
 }
 
-func (d *ClientDelegate) CreateRestoreTask(params *restore.CreateRestoreTaskParams, opts ...restore.ClientOption) (*restore.CreateRestoreTaskOK, error) {
-	return d.c.Restore.CreateRestoreTask(params, opts...)
+func (d *ClientDelegate) CreateRestoreTask(ctx context.Context, params *restore.CreateRestoreTaskParams, opts ...restore.ClientOption) (*restore.CreateRestoreTaskOK, error) {
+	resp, err := d.c.Restore.CreateRestoreTask(params.WithContext(ctx), opts...)
+	return resp, parseLegacyError(err)
 }
 
-func (d *ClientDelegate) GetRestoreTask(params *restore.GetRestoreTaskParams, opts ...restore.ClientOption) (*restore.GetRestoreTaskOK, error) {
-	return d.c.Restore.GetRestoreTask(params, opts...)
+func (d *ClientDelegate) GetRestoreTask(ctx context.Context, params *restore.GetRestoreTaskParams, opts ...restore.ClientOption) (*restore.GetRestoreTaskOK, error) {
+	resp, err := d.c.Restore.GetRestoreTask(params.WithContext(ctx), opts...)
+	return resp, parseLegacyError(err)
 }
 
-func (d *ClientDelegate) ListRestoreTasks(params *restore.ListRestoreTasksParams, opts ...restore.ClientOption) (*restore.ListRestoreTasksOK, error) {
-	return d.c.Restore.ListRestoreTasks(params, opts...)
+func (d *ClientDelegate) ListRestoreTasks(ctx context.Context, params *restore.ListRestoreTasksParams, opts ...restore.ClientOption) (*restore.ListRestoreTasksOK, error) {
+	resp, err := d.c.Restore.ListRestoreTasks(params.WithContext(ctx), opts...)
+	return resp, parseLegacyError(err)
 }
 
-func (d *ClientDelegate) CancelImport(params *importService.CancelImportParams, opts ...importService.ClientOption) (*importService.CancelImportOK, error) {
-	return d.ic.ImportService.CancelImport(params, opts...)
+func (d *ClientDelegate) CancelImport(ctx context.Context, params *importService.CancelImportParams, opts ...importService.ClientOption) (*importService.CancelImportOK, error) {
+	resp, err := d.ic.ImportService.CancelImport(params.WithContext(ctx), opts...)
+	return resp, parseLegacyError(err)
 }
 
-func (d *ClientDelegate) CreateImport(params *importService.CreateImportParams, opts ...importService.ClientOption) (*importService.CreateImportOK, error) {
-	return d.ic.ImportService.CreateImport(params, opts...)
+func (d *ClientDelegate) CreateImport(ctx context.Context, params *importService.CreateImportParams, opts ...importService.ClientOption) (*importService.CreateImportOK, error) {
+	resp, err := d.ic.ImportService.CreateImport(params.WithContext(ctx), opts...)
+	return resp, parseLegacyError(err)
 }
 
-func (d *ClientDelegate) GetImport(params *importService.GetImportParams, opts ...importService.ClientOption) (*importService.GetImportOK, error) {
-	return d.ic.ImportService.GetImport(params, opts...)
+func (d *ClientDelegate) GetImport(ctx context.Context, params *importService.GetImportParams, opts ...importService.ClientOption) (*importService.GetImportOK, error) {
+	resp, err := d.ic.ImportService.GetImport(params.WithContext(ctx), opts...)
+	return resp, parseLegacyError(err)
 }
 
-func (d *ClientDelegate) ListImports(params *importService.ListImportsParams, opts ...importService.ClientOption) (*importService.ListImportsOK, error) {
-	return d.ic.ImportService.ListImports(params, opts...)
+func (d *ClientDelegate) ListImports(ctx context.Context, params *importService.ListImportsParams, opts ...importService.ClientOption) (*importService.ListImportsOK, error) {
+	resp, err := d.ic.ImportService.ListImports(params.WithContext(ctx), opts...)
+	return resp, parseLegacyError(err)
 }
 
-func (d *ClientDelegate) GenerateUploadURL(params *importService.GenerateUploadURLParams, opts ...importService.ClientOption) (*importService.GenerateUploadURLOK, error) {
-	return d.ic.ImportService.GenerateUploadURL(params, opts...)
+func (d *ClientDelegate) GenerateUploadURL(ctx context.Context, params *importService.GenerateUploadURLParams, opts ...importService.ClientOption) (*importService.GenerateUploadURLOK, error) {
+	resp, err := d.ic.ImportService.GenerateUploadURL(params.WithContext(ctx), opts...)
+	return resp, parseLegacyError(err)
 }
 
-func (d *ClientDelegate) PreSignedUrlUpload(url *string, uploadFile *os.File, size int64) error {
-	request, err := http.NewRequest("PUT", *url, uploadFile)
+func (d *ClientDelegate) PreSignedUrlUpload(ctx context.Context, url *string, uploadFile *os.File, size int64) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPut, *url, uploadFile)
 	if err != nil {
 		return err
 	}
 	request.ContentLength = size
 
-	putRes, err := http.DefaultClient.Do(request)
+	putRes, err := d.uploadClient.Do(request)
 	if err != nil {
 		return err
 	}
@@ -178,12 +205,9 @@ func (d *ClientDelegate) PreSignedUrlUpload(url *string, uploadFile *os.File, si
 	return nil
 }
 
-func NewApiClient(publicKey string, privateKey string, apiUrl string, userAgent string) (*apiClient.GoTidbcloud, *importClient.GoTidbcloudImport, error) {
+func NewApiClient(publicKey string, privateKey string, apiUrl string, userAgent string, baseTransport http.RoundTripper) (*apiClient.GoTidbcloud, *importClient.GoTidbcloudImport, error) {
 	httpclient := &http.Client{
-		Transport: NewTransportWithAgent(&digest.Transport{
-			Username: publicKey,
-			Password: privateKey,
-		}, userAgent),
+		Transport: NewTransportWithAgent(newDigestTransport(publicKey, privateKey, baseTransport), userAgent),
 	}
 
 	// Parse the URL
@@ -2,12 +2,9 @@ package tidbcloud
 
 import (
 	"context"
-	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 
-	"github.com/icholy/digest"
 	"github.com/juju/errors"
 	"github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/dedicated"
 )
@@ -20,6 +17,7 @@ type TiDBCloudDedicatedClient interface {
 	ListRegions(ctx context.Context, cloudProvider string, projectId string) ([]dedicated.Commonv1beta1Region, error)
 	GetRegion(ctx context.Context, regionId string) (*dedicated.Commonv1beta1Region, error)
 	ListCloudProviders(ctx context.Context, projectId string) ([]dedicated.V1beta1RegionCloudProvider, error)
+	ListNodeSpecs(ctx context.Context, regionId string) ([]dedicated.Dedicatedv1beta1NodeSpec, error)
 	CreateCluster(ctx context.Context, body *dedicated.TidbCloudOpenApidedicatedv1beta1Cluster) (*dedicated.TidbCloudOpenApidedicatedv1beta1Cluster, error)
 	GetCluster(ctx context.Context, clusterId string) (*dedicated.TidbCloudOpenApidedicatedv1beta1Cluster, error)
 	ListClusters(ctx context.Context, projectId string, pageSize *int32, pageToken *string) (*dedicated.TidbCloudOpenApidedicatedv1beta1ListClustersResponse, error)
@@ -75,11 +73,8 @@ type DedicatedClientDelegate struct {
 	dc *dedicated.APIClient
 }
 
-func NewDedicatedClientDelegate(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string) (TiDBCloudDedicatedClient, error) {
-	transport := NewTransportWithAgent(&digest.Transport{
-		Username: publicKey,
-		Password: privateKey,
-	}, userAgent)
+func NewDedicatedClientDelegate(publicKey string, privateKey string, dedicatedEndpoint string, userAgent string, baseTransport http.RoundTripper) (TiDBCloudDedicatedClient, error) {
+	transport := NewTransportWithAgent(newDigestTransport(publicKey, privateKey, baseTransport), userAgent)
 
 	dc, err := NewDedicatedApiClient(transport, dedicatedEndpoint, userAgent)
 	if err != nil {
@@ -118,6 +113,11 @@ func (d *DedicatedClientDelegate) ListCloudProviders(ctx context.Context, projec
 	return resp.CloudProviders, parseError(err, h)
 }
 
+func (d *DedicatedClientDelegate) ListNodeSpecs(ctx context.Context, regionId string) ([]dedicated.Dedicatedv1beta1NodeSpec, error) {
+	resp, h, err := d.dc.RegionServiceAPI.RegionServiceShowNodeSpecs(ctx, regionId).Execute()
+	return resp.NodeSpecs, parseError(err, h)
+}
+
 func (d *DedicatedClientDelegate) CreateCluster(ctx context.Context, body *dedicated.TidbCloudOpenApidedicatedv1beta1Cluster) (*dedicated.TidbCloudOpenApidedicatedv1beta1Cluster, error) {
 	r := d.dc.ClusterServiceAPI.ClusterServiceCreateCluster(ctx)
 	if body != nil {
@@ -341,33 +341,6 @@ func (d *DedicatedClientDelegate) GetPublicEndpoint(ctx context.Context, cluster
 	return resp, parseError(err, h)
 }
 
-func parseError(err error, resp *http.Response) error {
-	defer func() {
-		if resp != nil {
-			resp.Body.Close()
-		}
-	}()
-	if err == nil {
-		return nil
-	}
-	if resp == nil {
-		return err
-	}
-	body, err1 := io.ReadAll(resp.Body)
-	if err1 != nil {
-		return err
-	}
-	path := "<path>"
-	if resp.Request != nil {
-		path = fmt.Sprintf("[%s %s]", resp.Request.Method, resp.Request.URL.Path)
-	}
-	traceId := "<trace_id>"
-	if resp.Header.Get("X-Debug-Trace-Id") != "" {
-		traceId = resp.Header.Get("X-Debug-Trace-Id")
-	}
-	return fmt.Errorf("%s[%s][%s] %s", path, err.Error(), traceId, body)
-}
-
 func validateApiUrl(value string) (*url.URL, error) {
 	u, err := url.ParseRequestURI(value)
 	if err != nil {
@@ -0,0 +1,185 @@
+package tidbcloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/juju/errors"
+)
+
+const traceIdHeader = "X-Debug-Trace-Id"
+
+// APIError is the error returned by all the TiDB Cloud clients when the server responds
+// with a non-2xx status code.
+type APIError struct {
+	StatusCode int
+	// Code is the error code in the response body, it is empty if the body carries no code.
+	Code    string
+	Message string
+	TraceID string
+	Method  string
+	Path    string
+	Details []interface{}
+	// body is the raw response body, used as the message when the body is not the standard error format.
+	body string
+}
+
+func (e *APIError) Error() string {
+	var sb strings.Builder
+	if e.Method != "" || e.Path != "" {
+		sb.WriteString(fmt.Sprintf("[%s %s]", e.Method, e.Path))
+	}
+	sb.WriteString(fmt.Sprintf("[%d %s] ", e.StatusCode, http.StatusText(e.StatusCode)))
+	switch {
+	case e.Message != "":
+		sb.WriteString(e.Message)
+	case e.body != "":
+		sb.WriteString(e.body)
+	default:
+		sb.WriteString("no error message returned")
+	}
+	if e.Code != "" {
+		sb.WriteString(fmt.Sprintf(" (code: %s)", e.Code))
+	}
+	if len(e.Details) > 0 {
+		if details, err := json.Marshal(e.Details); err == nil {
+			sb.WriteString(fmt.Sprintf("\ndetails: %s", details))
+		}
+	}
+	if e.TraceID != "" {
+		sb.WriteString(fmt.Sprintf("\ntrace_id: %s", e.TraceID))
+	}
+	return sb.String()
+}
+
+// IsNotFound returns true if the error is an APIError with status code 404.
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsConflict returns true if the error is an APIError with status code 409.
+func IsConflict(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+// IsRetryable returns true if the request failed because of rate limiting or a transient server error.
+func IsRetryable(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func hasStatusCode(err error, code int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == code
+}
+
+// errorBody is the error format shared by the TiDB Cloud APIs. The code is a number in
+// most of the APIs but a string in some of them, so it is decoded lazily.
+type errorBody struct {
+	Code    json.RawMessage `json:"code"`
+	Message string          `json:"message"`
+	Details []interface{}   `json:"details"`
+}
+
+func (b *errorBody) fill(apiErr *APIError) {
+	apiErr.Message = b.Message
+	apiErr.Details = b.Details
+	if len(b.Code) > 0 && string(b.Code) != "null" {
+		var code string
+		if err := json.Unmarshal(b.Code, &code); err != nil {
+			code = string(b.Code)
+		}
+		apiErr.Code = code
+	}
+}
+
+// parseError converts the error returned by the v1beta1 openapi clients into an APIError.
+func parseError(err error, resp *http.Response) error {
+	defer func() {
+		if resp != nil {
+			resp.Body.Close()
+		}
+	}()
+	if err == nil {
+		return nil
+	}
+	if resp == nil {
+		return err
+	}
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		TraceID:    resp.Header.Get(traceIdHeader),
+	}
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		apiErr.Path = resp.Request.URL.Path
+	}
+	body, err1 := io.ReadAll(resp.Body)
+	if err1 != nil {
+		apiErr.body = err.Error()
+		return apiErr
+	}
+	var eb errorBody
+	if json.Unmarshal(body, &eb) == nil {
+		eb.fill(apiErr)
+	}
+	apiErr.body = strings.TrimSpace(string(body))
+	return apiErr
+}
+
+// legacyOperationRe matches the "[METHOD /path][code]" prefix of the go-openapi error messages.
+var legacyOperationRe = regexp.MustCompile(`^\[(\w+) ([^\]]+)\]\[\d+\]`)
+
+// parseLegacyError converts the error returned by the go-openapi clients into an APIError.
+// Errors which do not carry a response, like network errors, are returned as they are.
+func parseLegacyError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var runtimeErr *runtime.APIError
+	if errors.As(err, &runtimeErr) {
+		apiErr := &APIError{
+			StatusCode: runtimeErr.Code,
+			Path:       runtimeErr.OperationName,
+		}
+		if r, ok := runtimeErr.Response.(runtime.ClientResponse); ok {
+			apiErr.TraceID = r.GetHeader(traceIdHeader)
+			apiErr.body = r.Message()
+		}
+		return apiErr
+	}
+
+	// All the go-openapi error responses expose the status code and a json payload.
+	coder, ok := err.(interface{ Code() int })
+	if !ok {
+		return err
+	}
+	apiErr := &APIError{
+		StatusCode: coder.Code(),
+		body:       err.Error(),
+	}
+	if m := legacyOperationRe.FindStringSubmatch(err.Error()); m != nil {
+		apiErr.Method = m[1]
+		apiErr.Path = m[2]
+	}
+	var payload struct {
+		Payload *errorBody
+	}
+	if raw, err1 := json.Marshal(err); err1 == nil && json.Unmarshal(raw, &payload) == nil && payload.Payload != nil {
+		payload.Payload.fill(apiErr)
+	}
+	return apiErr
+}
@@ -0,0 +1,229 @@
+package tidbcloud
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/juju/errors"
+)
+
+func testResponse(statusCode int, body string, traceID string) *http.Response {
+	header := http.Header{}
+	if traceID != "" {
+		header.Set(traceIdHeader, traceID)
+	}
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    &http.Request{Method: http.MethodGet, URL: &url.URL{Path: "/v1beta1/clusters/123"}},
+	}
+}
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		name       string
+		resp       *http.Response
+		statusCode int
+		code       string
+		message    string
+		details    int
+		traceID    string
+		contains   []string
+	}{
+		{
+			name:       "numeric code",
+			resp:       testResponse(http.StatusNotFound, `{"code": 49900007, "message": "cluster not found", "details": [{"reason": "NotFound"}]}`, "trace-1"),
+			statusCode: http.StatusNotFound,
+			code:       "49900007",
+			message:    "cluster not found",
+			details:    1,
+			traceID:    "trace-1",
+			contains:   []string{"[GET /v1beta1/clusters/123][404 Not Found] cluster not found (code: 49900007)", `details: [{"reason":"NotFound"}]`, "trace_id: trace-1"},
+		},
+		{
+			name:       "string code",
+			resp:       testResponse(http.StatusConflict, `{"code": "AlreadyExists", "message": "branch exists"}`, ""),
+			statusCode: http.StatusConflict,
+			code:       "AlreadyExists",
+			message:    "branch exists",
+			contains:   []string{"branch exists (code: AlreadyExists)"},
+		},
+		{
+			name:       "null code",
+			resp:       testResponse(http.StatusBadRequest, `{"code": null, "message": "invalid"}`, ""),
+			statusCode: http.StatusBadRequest,
+			message:    "invalid",
+		},
+		{
+			name:       "plain text body",
+			resp:       testResponse(http.StatusBadGateway, "upstream unavailable\n", ""),
+			statusCode: http.StatusBadGateway,
+			contains:   []string{"[502 Bad Gateway] upstream unavailable"},
+		},
+		{
+			name:       "empty body",
+			resp:       testResponse(http.StatusInternalServerError, "", ""),
+			statusCode: http.StatusInternalServerError,
+			contains:   []string{"no error message returned"},
+		},
+	}
+	for _, tt := range tests {
+		err := parseError(fmt.Errorf("%d", tt.statusCode), tt.resp)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Errorf("%s: expected an APIError, got %T", tt.name, err)
+			continue
+		}
+		if apiErr.StatusCode != tt.statusCode || apiErr.Code != tt.code || apiErr.Message != tt.message ||
+			len(apiErr.Details) != tt.details || apiErr.TraceID != tt.traceID {
+			t.Errorf("%s: unexpected error %+v", tt.name, apiErr)
+		}
+		for _, s := range tt.contains {
+			if !strings.Contains(err.Error(), s) {
+				t.Errorf("%s: expected %q in %q", tt.name, s, err.Error())
+			}
+		}
+	}
+}
+
+func TestParseErrorWithoutResponse(t *testing.T) {
+	if err := parseError(nil, testResponse(http.StatusOK, "{}", "")); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	networkErr := errors.New("connection refused")
+	if err := parseError(networkErr, nil); err != networkErr {
+		t.Errorf("expected the original error, got %v", err)
+	}
+}
+
+// legacyErrorPayload and legacyError have the shape of the errors generated by go-swagger.
+type legacyErrorPayload struct {
+	Code    int32         `json:"code,omitempty"`
+	Message string        `json:"message,omitempty"`
+	Details []interface{} `json:"details"`
+}
+
+type legacyError struct {
+	statusCode int
+	Payload    *legacyErrorPayload
+}
+
+func (o *legacyError) Error() string {
+	return fmt.Sprintf("[GET /api/v1beta/projects/{project_id}/clusters/{cluster_id}][%d] GetCluster default  %+v", o.statusCode, o.Payload)
+}
+
+func (o *legacyError) Code() int {
+	return o.statusCode
+}
+
+type legacyClientResponse struct {
+	code    int
+	message string
+	header  http.Header
+}
+
+func (r *legacyClientResponse) Code() int                       { return r.code }
+func (r *legacyClientResponse) Message() string                 { return r.message }
+func (r *legacyClientResponse) GetHeader(name string) string    { return r.header.Get(name) }
+func (r *legacyClientResponse) GetHeaders(name string) []string { return r.header.Values(name) }
+func (r *legacyClientResponse) Body() io.ReadCloser {
+	return io.NopCloser(strings.NewReader(""))
+}
+
+func TestParseLegacyError(t *testing.T) {
+	header := http.Header{}
+	header.Set(traceIdHeader, "trace-2")
+
+	tests := []struct {
+		name       string
+		err        error
+		statusCode int
+		code       string
+		message    string
+		method     string
+		path       string
+		traceID    string
+	}{
+		{
+			name:       "generated error",
+			err:        &legacyError{statusCode: http.StatusNotFound, Payload: &legacyErrorPayload{Code: 49900007, Message: "cluster not found"}},
+			statusCode: http.StatusNotFound,
+			code:       "49900007",
+			message:    "cluster not found",
+			method:     "GET",
+			path:       "/api/v1beta/projects/{project_id}/clusters/{cluster_id}",
+		},
+		{
+			name:       "generated error without payload",
+			err:        &legacyError{statusCode: http.StatusServiceUnavailable},
+			statusCode: http.StatusServiceUnavailable,
+			method:     "GET",
+			path:       "/api/v1beta/projects/{project_id}/clusters/{cluster_id}",
+		},
+		{
+			name:       "runtime error",
+			err:        runtime.NewAPIError("GetCluster", &legacyClientResponse{code: http.StatusTooManyRequests, message: "429 Too Many Requests", header: header}, http.StatusTooManyRequests),
+			statusCode: http.StatusTooManyRequests,
+			path:       "GetCluster",
+			traceID:    "trace-2",
+		},
+		{
+			name:       "wrapped runtime error",
+			err:        errors.Annotate(runtime.NewAPIError("DeleteCluster", nil, http.StatusBadGateway), "delete cluster"),
+			statusCode: http.StatusBadGateway,
+			path:       "DeleteCluster",
+		},
+	}
+	for _, tt := range tests {
+		err := parseLegacyError(tt.err)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Errorf("%s: expected an APIError, got %T", tt.name, err)
+			continue
+		}
+		if apiErr.StatusCode != tt.statusCode || apiErr.Code != tt.code || apiErr.Message != tt.message ||
+			apiErr.Method != tt.method || apiErr.Path != tt.path || apiErr.TraceID != tt.traceID {
+			t.Errorf("%s: unexpected error %+v", tt.name, apiErr)
+		}
+	}
+
+	if err := parseLegacyError(nil); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	networkErr := errors.New("connection refused")
+	if err := parseLegacyError(networkErr); err != networkErr {
+		t.Errorf("expected the original error, got %v", err)
+	}
+}
+
+func TestErrorStatusHelpers(t *testing.T) {
+	tests := []struct {
+		err       error
+		notFound  bool
+		conflict  bool
+		retryable bool
+	}{
+		{err: &APIError{StatusCode: http.StatusNotFound}, notFound: true},
+		{err: errors.Annotate(&APIError{StatusCode: http.StatusNotFound}, "get cluster"), notFound: true},
+		{err: &APIError{StatusCode: http.StatusConflict}, conflict: true},
+		{err: &APIError{StatusCode: http.StatusTooManyRequests}, retryable: true},
+		{err: &APIError{StatusCode: http.StatusBadGateway}, retryable: true},
+		{err: &APIError{StatusCode: http.StatusServiceUnavailable}, retryable: true},
+		{err: &APIError{StatusCode: http.StatusGatewayTimeout}, retryable: true},
+		{err: &APIError{StatusCode: http.StatusInternalServerError}},
+		{err: &APIError{StatusCode: http.StatusBadRequest}},
+		{err: errors.New("not found")},
+		{err: nil},
+	}
+	for _, tt := range tests {
+		if IsNotFound(tt.err) != tt.notFound || IsConflict(tt.err) != tt.conflict || IsRetryable(tt.err) != tt.retryable {
+			t.Errorf("unexpected status of %v: not found %v, conflict %v, retryable %v", tt.err, IsNotFound(tt.err), IsConflict(tt.err), IsRetryable(tt.err))
+		}
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"net/http"
 
-	"github.com/icholy/digest"
 	"github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/iam"
 )
 
@@ -45,11 +44,8 @@ type IAMClientDelegate struct {
 	ic *iam.APIClient
 }
 
-func NewIAMClientDelegate(publicKey string, privateKey string, iamEndpoint string, userAgent string) (TiDBCloudIAMClient, error) {
-	transport := NewTransportWithAgent(&digest.Transport{
-		Username: publicKey,
-		Password: privateKey,
-	}, userAgent)
+func NewIAMClientDelegate(publicKey string, privateKey string, iamEndpoint string, userAgent string, baseTransport http.RoundTripper) (TiDBCloudIAMClient, error) {
+	transport := NewTransportWithAgent(newDigestTransport(publicKey, privateKey, baseTransport), userAgent)
 
 	ic, err := NewIAMApiClient(transport, iamEndpoint, userAgent)
 	if err != nil {
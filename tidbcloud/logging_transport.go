@@ -0,0 +1,260 @@
+package tidbcloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/icholy/digest"
+)
+
+// The tflog subsystems used by the LoggingTransport of each client. The level of a subsystem
+// can be set separately with TF_LOG_PROVIDER_TIDBCLOUD_<SUBSYSTEM>, e.g. TF_LOG_PROVIDER_TIDBCLOUD_DEDICATED=TRACE.
+const (
+	LogSubsystemServerless = "serverless"
+	LogSubsystemDedicated  = "dedicated"
+	LogSubsystemIAM        = "iam"
+	LogSubsystemLegacy     = "legacy"
+)
+
+const (
+	// maxLoggedBodySize is the max size of a body logged at TRACE level, larger bodies are omitted.
+	maxLoggedBodySize = 64 * 1024
+	redactedValue     = "***"
+)
+
+// sensitiveKeys are the normalized (lower case, without "_" and "-") json keys and query
+// parameters whose values are redacted from the logs.
+var sensitiveKeys = map[string]struct{}{
+	"password":          {},
+	"rootpassword":      {},
+	"accesskey":         {},
+	"accesskeyid":       {},
+	"secretaccesskey":   {},
+	"sastoken":          {},
+	"serviceaccountkey": {},
+	"privatekey":        {},
+	"secret":            {},
+	"token":             {},
+	"sessiontoken":      {},
+	"credentials":       {},
+
+	// presigned url signatures of S3, GCS and Azure Blob
+	"sig":               {},
+	"signature":         {},
+	"xamzsignature":     {},
+	"xamzcredential":    {},
+	"xamzsecuritytoken": {},
+	"xgoogsignature":    {},
+	"xgoogcredential":   {},
+	"googleaccessid":    {},
+}
+
+// NewLoggingTransport returns a http.RoundTripper which logs the requests and responses through
+// the given tflog subsystem. Method, URL, status, latency and trace ID are logged at DEBUG level,
+// the json bodies are logged at TRACE level. Credentials in the bodies and the URL are redacted.
+func NewLoggingTransport(inner http.RoundTripper, subsystem string) http.RoundTripper {
+	if inner == nil {
+		inner = http.DefaultTransport
+	}
+	return &LoggingTransport{
+		inner:     inner,
+		subsystem: subsystem,
+	}
+}
+
+type LoggingTransport struct {
+	inner     http.RoundTripper
+	subsystem string
+}
+
+// newDigestTransport returns the digest auth transport of a client on top of baseTransport. When
+// baseTransport is a LoggingTransport, the logging is moved above the digest auth, so each request
+// is logged once instead of once for the digest challenge and once for the authorized retry.
+func newDigestTransport(publicKey string, privateKey string, baseTransport http.RoundTripper) http.RoundTripper {
+	if t, ok := baseTransport.(*LoggingTransport); ok {
+		return &LoggingTransport{
+			inner:     newDigestTransport(publicKey, privateKey, t.inner),
+			subsystem: t.subsystem,
+		}
+	}
+	return &digest.Transport{
+		Username:  publicKey,
+		Password:  privateKey,
+		Transport: baseTransport,
+	}
+}
+
+func (t *LoggingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(r.Context(), t.subsystem,
+		tflog.WithLevelFromEnv("TF_LOG_PROVIDER_TIDBCLOUD", strings.ToUpper(t.subsystem)))
+
+	fields := map[string]interface{}{
+		"http_method": r.Method,
+		"http_url":    redactURL(r.URL),
+	}
+	tflog.SubsystemDebug(ctx, t.subsystem, "Sending HTTP request", fields)
+	if r.Body != nil && r.Body != http.NoBody {
+		// peek the body of a clone, the request of the caller must not be modified
+		r = r.Clone(r.Context())
+		body, err := peekBody(&r.Body, r.Header.Get("Content-Type"), r.ContentLength)
+		if err != nil {
+			r.Body.Close()
+			return nil, err
+		}
+		tflog.SubsystemTrace(ctx, t.subsystem, "HTTP request body", map[string]interface{}{
+			"http_method":       r.Method,
+			"http_url":          fields["http_url"],
+			"http_request_body": body,
+		})
+	}
+
+	start := time.Now()
+	resp, err := t.inner.RoundTrip(r)
+	fields["http_latency_ms"] = time.Since(start).Milliseconds()
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, t.subsystem, "HTTP request failed", fields)
+		return resp, err
+	}
+
+	fields["http_status"] = resp.StatusCode
+	if traceId := resp.Header.Get(traceIdHeader); traceId != "" {
+		fields["trace_id"] = traceId
+	}
+	tflog.SubsystemDebug(ctx, t.subsystem, "Received HTTP response", fields)
+	if resp.Body != nil && resp.Body != http.NoBody {
+		body, err := peekBody(&resp.Body, resp.Header.Get("Content-Type"), resp.ContentLength)
+		if err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		tflog.SubsystemTrace(ctx, t.subsystem, "HTTP response body", map[string]interface{}{
+			"http_method":        r.Method,
+			"http_url":           fields["http_url"],
+			"http_status":        resp.StatusCode,
+			"http_response_body": body,
+		})
+	}
+	return resp, nil
+}
+
+// peekBody returns the redacted json body for logging and restores *body so it can still be read.
+// Non-json and large bodies, like the files uploaded to the presigned url, are not read.
+func peekBody(body *io.ReadCloser, contentType string, contentLength int64) (string, error) {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType != "application/json" && !strings.HasSuffix(mediaType, "+json") {
+		return fmt.Sprintf("<%s body omitted>", describeBody(mediaType, contentLength)), nil
+	}
+	if contentLength > maxLoggedBodySize {
+		return fmt.Sprintf("<%s body omitted>", describeBody(mediaType, contentLength)), nil
+	}
+
+	orig := *body
+	buf, err := io.ReadAll(io.LimitReader(orig, maxLoggedBodySize+1))
+	if err != nil {
+		return "", err
+	}
+	*body = &replayBody{
+		Reader: io.MultiReader(bytes.NewReader(buf), orig),
+		closer: orig,
+	}
+	if len(buf) > maxLoggedBodySize {
+		return fmt.Sprintf("<%s body omitted>", describeBody(mediaType, -1)), nil
+	}
+	return redactJSON(buf), nil
+}
+
+func describeBody(mediaType string, contentLength int64) string {
+	if mediaType == "" {
+		mediaType = "unknown content type"
+	}
+	if contentLength < 0 {
+		return fmt.Sprintf("large %s", mediaType)
+	}
+	return fmt.Sprintf("%d bytes %s", contentLength, mediaType)
+}
+
+type replayBody struct {
+	io.Reader
+	closer io.Closer
+}
+
+func (b *replayBody) Close() error {
+	return b.closer.Close()
+}
+
+// redactJSON replaces the values of the sensitive keys in a json document, like passwords,
+// access keys, SAS tokens and service account keys. Invalid json is not logged at all.
+func redactJSON(data []byte) string {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return ""
+	}
+	var v interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&v); err != nil {
+		return fmt.Sprintf("<%d bytes invalid json omitted>", len(data))
+	}
+	redacted, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return fmt.Sprintf("<%d bytes json omitted>", len(data))
+	}
+	return string(redacted)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, item := range value {
+			if isSensitiveKey(k) {
+				value[k] = redactedValue
+				continue
+			}
+			value[k] = redactValue(item)
+		}
+		return value
+	case []interface{}:
+		for i, item := range value {
+			value[i] = redactValue(item)
+		}
+		return value
+	default:
+		return v
+	}
+}
+
+func redactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	redacted := *u
+	redacted.User = nil
+	query := redacted.Query()
+	changed := false
+	for k := range query {
+		if isSensitiveKey(k) {
+			query.Set(k, redactedValue)
+			changed = true
+		}
+	}
+	if changed {
+		redacted.RawQuery = query.Encode()
+	}
+	return redacted.String()
+}
+
+func isSensitiveKey(key string) bool {
+	normalized := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+	if _, ok := sensitiveKeys[normalized]; ok {
+		return true
+	}
+	return strings.Contains(normalized, "password") || strings.Contains(normalized, "secret")
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"net/http"
 
-	"github.com/icholy/digest"
 	"github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/serverless/br"
 	"github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/serverless/branch"
 	"github.com/tidbcloud/tidbcloud-cli/pkg/tidbcloud/v1beta1/serverless/cluster"
@@ -100,11 +99,8 @@ type ServerlessClientDelegate struct {
 	ec  *export.APIClient
 }
 
-func NewServerlessClientDelegate(publicKey string, privateKey string, serverlessEndpoint string, userAgent string) (TiDBCloudServerlessClient, error) {
-	transport := NewTransportWithAgent(&digest.Transport{
-		Username: publicKey,
-		Password: privateKey,
-	}, userAgent)
+func NewServerlessClientDelegate(publicKey string, privateKey string, serverlessEndpoint string, userAgent string, baseTransport http.RoundTripper) (TiDBCloudServerlessClient, error) {
+	transport := NewTransportWithAgent(newDigestTransport(publicKey, privateKey, baseTransport), userAgent)
 
 	bc, sc, brc, sic, ec, err := NewServerlessApiClient(transport, serverlessEndpoint, userAgent)
 	if err != nil {
@@ -0,0 +1,104 @@
+package tidbcloud
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// TransportConfig holds the network settings shared by all the TiDB Cloud clients.
+type TransportConfig struct {
+	// HTTPProxy is the proxy URL used for all requests. When empty, the proxy is
+	// taken from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+	HTTPProxy string
+	// CACertFile is the path to a PEM encoded CA bundle trusted in addition to the system roots.
+	CACertFile string
+	// CACertPEM is a PEM encoded CA bundle trusted in addition to the system roots.
+	CACertPEM string
+	// ClientCert and ClientKey are the PEM encoded certificate and key (or paths to them)
+	// presented to the server for mutual TLS.
+	ClientCert string
+	ClientKey  string
+	// InsecureSkipVerify disables the verification of the server certificate.
+	InsecureSkipVerify bool
+}
+
+// NewBaseTransport returns the http.Transport every client is built on. A nil config
+// returns a clone of http.DefaultTransport.
+func NewBaseTransport(cfg *TransportConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg == nil {
+		return transport, nil
+	}
+
+	if cfg.HTTPProxy != "" {
+		proxyURL, err := url.Parse(cfg.HTTPProxy)
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid http_proxy %q, it should format as <schema>://<host>[:<port>]", cfg.HTTPProxy)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+	}
+
+	if cfg.CACertFile != "" || cfg.CACertPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if cfg.CACertFile != "" {
+			pem, err := os.ReadFile(cfg.CACertFile)
+			if err != nil {
+				return nil, errors.Annotate(err, "read ca_cert_file")
+			}
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("no valid certificate found in ca_cert_file %s", cfg.CACertFile)
+			}
+		}
+		if cfg.CACertPEM != "" {
+			if !pool.AppendCertsFromPEM([]byte(cfg.CACertPEM)) {
+				return nil, errors.New("no valid certificate found in ca_cert_pem")
+			}
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.ClientCert != "" || cfg.ClientKey != "" {
+		if cfg.ClientCert == "" || cfg.ClientKey == "" {
+			return nil, errors.New("client_cert and client_key must be set together")
+		}
+		certPEM, err := readPEM(cfg.ClientCert)
+		if err != nil {
+			return nil, errors.Annotate(err, "read client_cert")
+		}
+		keyPEM, err := readPEM(cfg.ClientKey)
+		if err != nil {
+			return nil, errors.Annotate(err, "read client_key")
+		}
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, errors.Annotate(err, "load client certificate")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
+
+// readPEM returns value itself when it is PEM encoded, otherwise it is treated as a file path.
+func readPEM(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+	return os.ReadFile(value)
+}
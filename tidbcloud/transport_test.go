@@ -0,0 +1,169 @@
+package tidbcloud
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testCertificate returns a self-signed PEM encoded certificate and its key.
+func testCertificate(t *testing.T) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "tidbcloud-test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return string(certPEM), string(keyPEM)
+}
+
+func writeTestFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write %s: %v", name, err)
+	}
+	return path
+}
+
+func TestNewBaseTransportNilConfig(t *testing.T) {
+	transport, err := NewBaseTransport(nil)
+	if err != nil || transport == nil {
+		t.Fatalf("expected a transport, got %v, %v", transport, err)
+	}
+	if transport == http.DefaultTransport {
+		t.Errorf("expected a clone of the default transport")
+	}
+}
+
+func TestNewBaseTransportProxy(t *testing.T) {
+	transport, err := NewBaseTransport(&TransportConfig{HTTPProxy: "http://proxy.example.com:3128"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	req, _ := http.NewRequest(http.MethodGet, "https://dedicated.tidbapi.com/v1beta1/clusters", nil)
+	proxyURL, err := transport.Proxy(req)
+	if err != nil || proxyURL == nil || proxyURL.String() != "http://proxy.example.com:3128" {
+		t.Errorf("expected the configured proxy, got %v, %v", proxyURL, err)
+	}
+
+	for _, proxy := range []string{"proxy.example.com:3128", "://proxy", "http://"} {
+		if _, err := NewBaseTransport(&TransportConfig{HTTPProxy: proxy}); err == nil {
+			t.Errorf("expected an error for http_proxy %q", proxy)
+		}
+	}
+}
+
+func TestNewBaseTransportCACert(t *testing.T) {
+	certPEM, _ := testCertificate(t)
+
+	transport, err := NewBaseTransport(&TransportConfig{CACertPEM: certPEM})
+	if err != nil || transport.TLSClientConfig.RootCAs == nil {
+		t.Fatalf("expected a root CA pool, got %v", err)
+	}
+	if _, err := NewBaseTransport(&TransportConfig{CACertFile: writeTestFile(t, "ca.pem", certPEM)}); err != nil {
+		t.Errorf("unexpected error for ca_cert_file: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		cfg  *TransportConfig
+		err  string
+	}{
+		{name: "invalid pem", cfg: &TransportConfig{CACertPEM: "not a certificate"}, err: "ca_cert_pem"},
+		{name: "invalid file", cfg: &TransportConfig{CACertFile: writeTestFile(t, "invalid.pem", "not a certificate")}, err: "ca_cert_file"},
+		{name: "missing file", cfg: &TransportConfig{CACertFile: filepath.Join(t.TempDir(), "missing.pem")}, err: "ca_cert_file"},
+	}
+	for _, tt := range tests {
+		if _, err := NewBaseTransport(tt.cfg); err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("%s: expected an error containing %q, got %v", tt.name, tt.err, err)
+		}
+	}
+}
+
+func TestNewBaseTransportClientCert(t *testing.T) {
+	certPEM, keyPEM := testCertificate(t)
+	_, otherKeyPEM := testCertificate(t)
+	certFile := writeTestFile(t, "client.pem", certPEM)
+	keyFile := writeTestFile(t, "client-key.pem", keyPEM)
+
+	for _, cfg := range []*TransportConfig{
+		{ClientCert: certPEM, ClientKey: keyPEM},
+		{ClientCert: certFile, ClientKey: keyFile},
+		{ClientCert: certFile, ClientKey: keyPEM},
+	} {
+		transport, err := NewBaseTransport(cfg)
+		if err != nil || len(transport.TLSClientConfig.Certificates) != 1 {
+			t.Errorf("expected a client certificate, got %v", err)
+		}
+	}
+
+	tests := []struct {
+		name string
+		cfg  *TransportConfig
+		err  string
+	}{
+		{name: "cert only", cfg: &TransportConfig{ClientCert: certPEM}, err: "must be set together"},
+		{name: "key only", cfg: &TransportConfig{ClientKey: keyPEM}, err: "must be set together"},
+		{name: "missing cert file", cfg: &TransportConfig{ClientCert: filepath.Join(t.TempDir(), "missing.pem"), ClientKey: keyPEM}, err: "read client_cert"},
+		{name: "missing key file", cfg: &TransportConfig{ClientCert: certPEM, ClientKey: filepath.Join(t.TempDir(), "missing.pem")}, err: "read client_key"},
+		{name: "mismatched key", cfg: &TransportConfig{ClientCert: certPEM, ClientKey: otherKeyPEM}, err: "load client certificate"},
+		{name: "invalid cert", cfg: &TransportConfig{ClientCert: writeTestFile(t, "invalid.pem", "not a certificate"), ClientKey: keyPEM}, err: "load client certificate"},
+	}
+	for _, tt := range tests {
+		if _, err := NewBaseTransport(tt.cfg); err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("%s: expected an error containing %q, got %v", tt.name, tt.err, err)
+		}
+	}
+}
+
+func TestNewBaseTransportInsecureSkipVerify(t *testing.T) {
+	transport, err := NewBaseTransport(&TransportConfig{InsecureSkipVerify: true})
+	if err != nil || !transport.TLSClientConfig.InsecureSkipVerify {
+		t.Errorf("expected InsecureSkipVerify to be set, got %v", err)
+	}
+	transport, err = NewBaseTransport(&TransportConfig{})
+	if err != nil || transport.TLSClientConfig.InsecureSkipVerify || transport.TLSClientConfig.MinVersion == 0 {
+		t.Errorf("expected certificate verification with a minimum TLS version, got %v", err)
+	}
+}
+
+func TestReadPEM(t *testing.T) {
+	certPEM, _ := testCertificate(t)
+	if got, err := readPEM(certPEM); err != nil || string(got) != certPEM {
+		t.Errorf("expected the PEM value itself, got %v", err)
+	}
+	if got, err := readPEM(writeTestFile(t, "cert.pem", certPEM)); err != nil || string(got) != certPEM {
+		t.Errorf("expected the content of the file, got %v", err)
+	}
+	if _, err := readPEM(filepath.Join(t.TempDir(), "missing.pem")); err == nil {
+		t.Errorf("expected an error for a missing file")
+	}
+}